@@ -11,7 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var outputDir string
+var (
+	outputDir   string
+	mergeOutput string
+)
 
 var batchCmd = &cobra.Command{
 	Use:   "batch [glob-pattern]",
@@ -21,21 +24,26 @@ var batchCmd = &cobra.Command{
 Examples:
   pdfy batch "*.md" --output-dir pdfs/
   pdfy batch "docs/**/*.md" --template technical
-  pdfy batch "./markdown_files/*.md"`,
+  pdfy batch "./markdown_files/*.md"
+  pdfy batch "chapters/*.md" --merge book.pdf`,
 	Args: cobra.ExactArgs(1),
 	RunE: batchConvert,
 }
 
 func init() {
 	batchCmd.Flags().StringVar(&outputDir, "output-dir", "", "Output directory for PDF files")
-	batchCmd.Flags().StringVarP(&templateName, "template", "t", "default", "Template to use")
-	batchCmd.Flags().StringVar(&cssPath, "css", "", "Custom CSS file path")
-	batchCmd.Flags().StringVar(&theme, "theme", "light", "Theme to use")
+	batchCmd.Flags().StringVar(&mergeOutput, "merge", "", "Combine all files into a single PDF book at this path")
+	batchCmd.Flags().StringVar(&summaryPath, "summary", "", "SUMMARY.md file listing the chapters in order (with --merge)")
+	addConversionFlags(batchCmd)
 }
 
 func batchConvert(cmd *cobra.Command, args []string) error {
 	pattern := args[0]
 
+	if err := loadPasswords(); err != nil {
+		return err
+	}
+
 	// Find matching files
 	matches, err := filepath.Glob(pattern)
 	if err != nil {
@@ -46,6 +54,11 @@ func batchConvert(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no files found matching pattern: %s", pattern)
 	}
 
+	// Combine the files into a book instead of converting them one by one
+	if mergeOutput != "" {
+		return makeBook(matches, filepath.Dir(pattern), mergeOutput)
+	}
+
 	// Create output directory if specified
 	if outputDir != "" {
 		if err := os.MkdirAll(outputDir, 0o755); err != nil {
@@ -55,6 +68,12 @@ func batchConvert(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d files to convert\n", len(matches))
 
+	// Share one browser across all files
+	browser := newBrowser(converter.DefaultPoolSize)
+	if browser != nil {
+		defer browser.Close()
+	}
+
 	successCount := 0
 	for _, inputPath := range matches {
 		// Skip non-markdown files
@@ -75,15 +94,7 @@ func batchConvert(cmd *cobra.Command, args []string) error {
 		}
 
 		// Convert file
-		config := &converter.Config{
-			InputPath:    inputPath,
-			OutputPath:   outPath,
-			TemplateName: templateName,
-			CSSPath:      cssPath,
-			Theme:        theme,
-		}
-
-		conv := converter.New(config)
+		conv := converter.New(newConfig(inputPath, outPath, browser))
 
 		fmt.Printf("Converting %s...", filepath.Base(inputPath))
 
@@ -93,6 +104,7 @@ func batchConvert(cmd *cobra.Command, args []string) error {
 		}
 
 		fmt.Printf(" ✓ Success\n")
+		printWarnings(inputPath, conv.GetWarnings())
 		successCount++
 	}
 
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/himprakashdas/pdfy/internal/converter"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	bookOutput  string
+	summaryPath string
+)
+
+var bookCmd = &cobra.Command{
+	Use:   "book [directory|glob-pattern]",
+	Short: "Combine Markdown files into a single PDF book",
+	Long: `Combine the Markdown files in a directory, or matching a glob pattern, into a
+single PDF with one table of contents and continuous page numbers.
+
+Chapters are read in the order of the SUMMARY.md file next to them if there is
+one. Otherwise they are sorted by the order or weight in their front matter,
+then by file name.
+
+Examples:
+  pdfy book docs/ -o manual.pdf
+  pdfy book "chapters/*.md" -o book.pdf
+  pdfy book docs/ --summary docs/SUMMARY.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: buildBook,
+}
+
+func init() {
+	bookCmd.Flags().StringVarP(&bookOutput, "output", "o", "book.pdf", "Output PDF file path")
+	bookCmd.Flags().StringVar(&summaryPath, "summary", "", "SUMMARY.md file listing the chapters in order")
+	addConversionFlags(bookCmd)
+}
+
+func buildBook(cmd *cobra.Command, args []string) error {
+	if err := loadPasswords(); err != nil {
+		return err
+	}
+
+	// A directory stands for the Markdown files in it
+	dir, pattern := args[0], args[0]
+	if info, err := os.Stat(args[0]); err == nil && info.IsDir() {
+		pattern = filepath.Join(args[0], "*.md")
+	} else {
+		dir = filepath.Dir(args[0])
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid glob pattern: %w", err)
+	}
+
+	return makeBook(matches, dir, bookOutput)
+}
+
+// makeBook combines the Markdown files among paths into a single PDF. A
+// SUMMARY.md in dir, or the one given with --summary, selects and orders
+// the chapters instead.
+func makeBook(paths []string, dir, outPath string) error {
+	chapters, title, err := bookChapters(paths, dir)
+	if err != nil {
+		return err
+	}
+
+	config := newConfig(chapters[0], outPath, nil)
+	if config.Title == "" {
+		config.Title = title
+	}
+	conv := converter.New(config)
+
+	fmt.Printf("Combining %d files into %s...\n", len(chapters), outPath)
+
+	if err := conv.ConvertBook(chapters); err != nil {
+		return fmt.Errorf("conversion failed: %w", err)
+	}
+
+	fmt.Printf("✓ Successfully created %s\n", outPath)
+	for _, w := range conv.GetWarnings() {
+		fmt.Printf("  ⚠ %v\n", w)
+	}
+	return nil
+}
+
+// bookChapters returns the chapter files in reading order, and the title
+// from the summary if there is one
+func bookChapters(paths []string, dir string) ([]string, string, error) {
+	summary := summaryPath
+	if summary == "" {
+		if path := filepath.Join(dir, converter.SummaryFile); fileExists(path) {
+			summary = path
+		}
+	}
+	if summary != "" {
+		return converter.ReadSummary(summary)
+	}
+
+	var chapters []string
+	for _, path := range paths {
+		if isMarkdownFile(path) && !strings.EqualFold(filepath.Base(path), converter.SummaryFile) {
+			chapters = append(chapters, path)
+		}
+	}
+	if len(chapters) == 0 {
+		return nil, "", fmt.Errorf("no Markdown files found in %s", dir)
+	}
+
+	chapters, err := converter.SortChapters(chapters)
+	return chapters, "", err
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -11,12 +11,7 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	outputPath   string
-	templateName string
-	cssPath      string
-	theme        string
-)
+var outputPath string
 
 var convertCmd = &cobra.Command{
 	Use:   "convert [input.md]",
@@ -33,14 +28,16 @@ Examples:
 
 func init() {
 	convertCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output PDF file path")
-	convertCmd.Flags().StringVar(&cssPath, "css", "", "Custom CSS file path")
-	convertCmd.Flags().StringVarP(&templateName, "template", "t", "default", "Template to use (default, technical)")
-	convertCmd.Flags().StringVar(&theme, "theme", "light", "Theme to use (light)")
+	addConversionFlags(convertCmd)
 }
 
 func convertFile(cmd *cobra.Command, args []string) error {
 	inputPath := args[0]
 
+	if err := loadPasswords(); err != nil {
+		return err
+	}
+
 	// Validate input file exists
 	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
 		return fmt.Errorf("input file does not exist: %s", inputPath)
@@ -53,15 +50,7 @@ func convertFile(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create converter instance
-	config := &converter.Config{
-		InputPath:    inputPath,
-		OutputPath:   outputPath,
-		TemplateName: templateName,
-		CSSPath:      cssPath,
-		Theme:        theme,
-	}
-
-	conv := converter.New(config)
+	conv := converter.New(newConfig(inputPath, outputPath, nil))
 
 	fmt.Printf("Converting %s to %s...\n", inputPath, outputPath)
 
@@ -70,5 +59,6 @@ func convertFile(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("✓ Successfully converted to %s\n", outputPath)
+	printWarnings(inputPath, conv.GetWarnings())
 	return nil
 }
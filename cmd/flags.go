@@ -0,0 +1,292 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/himprakashdas/pdfy/internal/converter"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateName string
+	cssPath      string
+	theme        string
+	rendererName string
+	templateDirs []string
+
+	chromeURL   string
+	chromePath  string
+	chromeFlags []string
+	noSandbox   bool
+
+	paperSize         string
+	landscape         bool
+	margin            string
+	marginTop         string
+	marginBottom      string
+	marginLeft        string
+	marginRight       string
+	scale             float64
+	pageRanges        string
+	preferCSSPageSize bool
+	pageBreakBefore   []string
+	oddPageChapters   bool
+	cover             bool
+
+	header string
+	footer string
+
+	title    string
+	author   string
+	subject  string
+	keywords []string
+	language string
+
+	toc            bool
+	tocDepth       int
+	tocTitle       string
+	tocPageNumbers bool
+
+	outlineDepth int
+	tagged       bool
+	embedImages  bool
+
+	allowHTML           bool
+	htmlTags            []string
+	htmlAttributes      []string
+	htmlRemoteResources bool
+
+	bibliography  []string
+	citationStyle string
+
+	watermark         string
+	watermarkImage    string
+	watermarkOpacity  float64
+	watermarkPosition string
+	watermarkSize     int
+
+	encrypt       bool
+	userPassword  string
+	ownerPassword string
+	passwordFile  string
+	allow         []string
+)
+
+// addConversionFlags registers the flags shared by convert, batch and watch
+func addConversionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&templateName, "template", "t", "default", "Template name or .html file to use (see pdfy templates list)")
+	cmd.Flags().StringVar(&cssPath, "css", "", "Custom CSS file path")
+	cmd.Flags().StringVar(&theme, "theme", "light", "Theme name or .css file to use (see pdfy themes list)")
+	cmd.Flags().StringSliceVar(&templateDirs, "template-dir", nil,
+		"Directory searched first for templates (NAME.html) and themes (NAME.css) (repeatable)")
+	cmd.Flags().StringVar(&rendererName, "renderer", "chrome",
+		fmt.Sprintf("PDF rendering engine (%s)", strings.Join(converter.RendererNames(), ", ")))
+
+	cmd.Flags().StringVar(&chromeURL, "chrome-url", os.Getenv("PDFY_CHROME_URL"),
+		"DevTools URL of a running Chrome to use instead of launching one (env PDFY_CHROME_URL)")
+	cmd.Flags().StringVar(&chromePath, "chrome-path", os.Getenv("CHROME_BIN"), "Chrome executable to launch (env CHROME_BIN)")
+	cmd.Flags().StringSliceVar(&chromeFlags, "chrome-flag", nil, "Extra Chrome command line flag, e.g. disable-gpu (repeatable)")
+	cmd.Flags().BoolVar(&noSandbox, "no-sandbox", false, "Disable the Chrome sandbox (needed when running as root in Docker)")
+
+	cmd.Flags().StringVar(&paperSize, "paper", "A4",
+		fmt.Sprintf("Paper size (%s) or WIDTHxHEIGHT, e.g. 210mmx297mm", strings.Join(converter.PaperSizeNames(), ", ")))
+	cmd.Flags().BoolVar(&landscape, "landscape", false, "Use landscape orientation")
+	cmd.Flags().StringVar(&margin, "margin", "0.4in", "Page margins as CSS shorthand in mm, cm, in or pt, e.g. \"20mm\" or \"1in 0.75in\"")
+	cmd.Flags().StringVar(&marginTop, "margin-top", "", "Top margin, overrides --margin")
+	cmd.Flags().StringVar(&marginBottom, "margin-bottom", "", "Bottom margin, overrides --margin")
+	cmd.Flags().StringVar(&marginLeft, "margin-left", "", "Left margin, overrides --margin")
+	cmd.Flags().StringVar(&marginRight, "margin-right", "", "Right margin, overrides --margin")
+	cmd.Flags().Float64Var(&scale, "scale", 1, "Scale of the page content, between 0.1 and 2")
+	cmd.Flags().StringVar(&pageRanges, "page-ranges", "", "Pages to include, e.g. \"1-5, 8\"")
+	cmd.Flags().BoolVar(&preferCSSPageSize, "css-page-size", true, "Let an @page size in the CSS override --paper")
+	cmd.Flags().StringSliceVar(&pageBreakBefore, "page-break-before", nil, "Heading levels that start a new page, e.g. h1,h2")
+	cmd.Flags().BoolVar(&oddPageChapters, "odd-page-chapters", false, "Start chapters (book files or level 1 headings) on right-hand pages")
+	cmd.Flags().BoolVar(&cover, "cover", false, "Add a cover page from the title, subtitle, author, date, version, organization, logo and cover_image in the front matter")
+
+	cmd.Flags().StringVar(&header, "header", "",
+		"Running header HTML; tokens: {{page}}, {{pages}}, {{title}}, {{date}}, {{author}} (\"none\" to disable)")
+	cmd.Flags().StringVar(&footer, "footer", "", "Running footer HTML, same tokens as --header (\"none\" to disable)")
+
+	cmd.Flags().StringVar(&title, "title", "", "Document title, overrides the front matter")
+	cmd.Flags().StringVar(&author, "author", "", "Document author, overrides the front matter")
+	cmd.Flags().StringVar(&subject, "subject", "", "Document subject, overrides the front matter")
+	cmd.Flags().StringSliceVar(&keywords, "keywords", nil, "Comma separated document keywords, overrides the front matter")
+	cmd.Flags().StringVar(&language, "language", "", "Document language such as en-US, overrides the front matter")
+	cmd.Flags().BoolVar(&toc, "toc", false, "Add a table of contents, where a <!-- TOC --> marker is or at the start")
+	cmd.Flags().IntVar(&tocDepth, "toc-depth", 0, fmt.Sprintf("Deepest heading level in the table of contents (default %d, top two levels in books)", converter.DefaultTOCDepth))
+	cmd.Flags().StringVar(&tocTitle, "toc-title", "", "Title of the table of contents (default \"Table of Contents\")")
+	cmd.Flags().BoolVar(&tocPageNumbers, "toc-page-numbers", false, "Add page numbers to the table of contents, rendering the document twice")
+	cmd.Flags().BoolVar(&tagged, "tagged", false, "Produce a tagged, accessible PDF and warn about accessibility problems")
+	cmd.Flags().IntVar(&outlineDepth, "outline-depth", converter.DefaultOutlineDepth, "Deepest heading level in the PDF bookmarks (0 to disable)")
+	cmd.Flags().BoolVar(&embedImages, "embed-images", false, "Inline local images into the HTML as data URLs")
+
+	cmd.Flags().BoolVar(&allowHTML, "allow-html", false, "Keep raw HTML in the Markdown, without scripts, event handlers and remote resources")
+	cmd.Flags().StringSliceVar(&htmlTags, "html-tags", nil, "Tags to allow in raw HTML besides the defaults, e.g. video,source")
+	cmd.Flags().StringSliceVar(&htmlAttributes, "html-attributes", nil, "Attributes to allow in raw HTML besides the defaults, e.g. controls")
+	cmd.Flags().BoolVar(&htmlRemoteResources, "html-remote-resources", false, "Let raw HTML load images and other resources from other hosts")
+
+	cmd.Flags().StringSliceVar(&bibliography, "bibliography", nil, "BibTeX (.bib) or CSL-JSON (.json) file for [@key] citations, overrides the front matter")
+	cmd.Flags().StringVar(&citationStyle, "citation-style", "",
+		fmt.Sprintf("Citation style: %s or %s (default %s)", converter.NumericStyle, converter.AuthorYearStyle, converter.AuthorYearStyle))
+
+	cmd.Flags().StringVar(&watermark, "watermark", "", "Watermark text printed across every page, e.g. CONFIDENTIAL")
+	cmd.Flags().StringVar(&watermarkImage, "watermark-image", "", "Image (PNG, JPEG or TIFF) to use as watermark instead of text")
+	cmd.Flags().Float64Var(&watermarkOpacity, "watermark-opacity", 0.2, "Watermark opacity, between 0 and 1")
+	cmd.Flags().StringVar(&watermarkPosition, "watermark-position", "center",
+		fmt.Sprintf("Watermark position (%s)", strings.Join(converter.WatermarkPositionNames(), ", ")))
+	cmd.Flags().IntVar(&watermarkSize, "watermark-size", 72, "Watermark font size in points")
+
+	cmd.Flags().BoolVar(&encrypt, "encrypt", false, "Encrypt the PDF with AES-256 (implied by the password flags)")
+	cmd.Flags().StringVar(&userPassword, "user-password", "", "Password needed to open the PDF (env PDFY_USER_PASSWORD)")
+	cmd.Flags().StringVar(&ownerPassword, "owner-password", "", "Password that lifts the permission restrictions (env PDFY_OWNER_PASSWORD)")
+	cmd.Flags().StringVar(&passwordFile, "password-file", "", "File with the user password on the first line and the owner password on the second")
+	cmd.Flags().StringSliceVar(&allow, "allow", nil,
+		fmt.Sprintf("Permissions for encrypted PDFs (%s), nothing by default", strings.Join(converter.PermissionNames(), ", ")))
+}
+
+// newConfig builds a converter configuration from the command line flags
+func newConfig(inputPath, outPath string, browser *converter.BrowserPool) *converter.Config {
+	return &converter.Config{
+		InputPath:    inputPath,
+		OutputPath:   outPath,
+		TemplateName: templateName,
+		CSSPath:      cssPath,
+		Theme:        theme,
+		RendererName: rendererName,
+		TemplateDirs: templateDirs,
+
+		PaperSize:         paperSize,
+		Landscape:         landscape,
+		Margin:            margin,
+		MarginTop:         marginTop,
+		MarginBottom:      marginBottom,
+		MarginLeft:        marginLeft,
+		MarginRight:       marginRight,
+		Scale:             scale,
+		PageRanges:        pageRanges,
+		PreferCSSPageSize: preferCSSPageSize,
+		PageBreakBefore:   pageBreakBefore,
+		OddPageChapters:   oddPageChapters,
+		Cover:             cover,
+		Header:            header,
+		Footer:            footer,
+
+		Title:    title,
+		Author:   author,
+		Subject:  subject,
+		Keywords: keywords,
+		Language: language,
+
+		TOC:            toc,
+		TOCDepth:       tocDepth,
+		TOCTitle:       tocTitle,
+		TOCPageNumbers: tocPageNumbers,
+
+		OutlineDepth: outlineDepth,
+		Tagged:       tagged,
+		EmbedImages:  embedImages,
+		Watermark:    watermarkOptions(),
+		Encryption:   encryptionOptions(),
+
+		Bibliography:  bibliography,
+		CitationStyle: citationStyle,
+
+		RawHTML: allowHTML,
+		HTML: converter.HTMLOptions{
+			Tags:            htmlTags,
+			Attributes:      htmlAttributes,
+			RemoteResources: htmlRemoteResources,
+		},
+
+		Browser: browser,
+		Chrome:  browserOptions(),
+	}
+}
+
+// loadPasswords reads the encryption passwords from the password file or
+// the environment when they weren't given as flags
+func loadPasswords() error {
+	if passwordFile != "" {
+		data, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return fmt.Errorf("failed to read password file: %w", err)
+		}
+
+		lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+		if userPassword == "" {
+			userPassword = lines[0]
+		}
+		if ownerPassword == "" && len(lines) > 1 {
+			ownerPassword = lines[1]
+		}
+		encrypt = true
+	}
+
+	// Passwords in the environment turn on encryption as the flags do
+	if userPassword == "" {
+		userPassword = os.Getenv("PDFY_USER_PASSWORD")
+	}
+	if ownerPassword == "" {
+		ownerPassword = os.Getenv("PDFY_OWNER_PASSWORD")
+	}
+
+	if userPassword != "" || ownerPassword != "" || len(allow) > 0 {
+		encrypt = true
+	}
+
+	return nil
+}
+
+// watermarkOptions returns the watermark settings from the command line
+// flags. The options apply to watermarks set in front matter too.
+func watermarkOptions() *converter.WatermarkOptions {
+	return &converter.WatermarkOptions{
+		Text:     watermark,
+		Image:    watermarkImage,
+		Opacity:  watermarkOpacity,
+		Position: watermarkPosition,
+		FontSize: watermarkSize,
+	}
+}
+
+// encryptionOptions returns the encryption settings from the command line
+// flags, or nil if the PDF shouldn't be encrypted
+func encryptionOptions() *converter.EncryptionOptions {
+	if !encrypt {
+		return nil
+	}
+	return &converter.EncryptionOptions{
+		UserPassword:  userPassword,
+		OwnerPassword: ownerPassword,
+		Permissions:   allow,
+	}
+}
+
+// printWarnings reports the problems found while converting a file
+func printWarnings(inputPath string, warnings []*converter.ConversionError) {
+	for _, w := range warnings {
+		fmt.Printf("  ⚠ %s: %v\n", inputPath, w)
+	}
+}
+
+// browserOptions returns the Chrome settings from the command line flags
+func browserOptions() converter.BrowserOptions {
+	return converter.BrowserOptions{
+		RemoteURL: chromeURL,
+		ExecPath:  chromePath,
+		Flags:     chromeFlags,
+		NoSandbox: noSandbox,
+	}
+}
+
+// newBrowser starts a shared browser for the Chrome renderer. It returns
+// nil when another renderer is selected.
+func newBrowser(size int) *converter.BrowserPool {
+	if rendererName != "" && rendererName != "chrome" {
+		return nil
+	}
+	return converter.NewBrowserPool(size, browserOptions())
+}
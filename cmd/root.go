@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"github.com/himprakashdas/pdfy/internal/converter"
+
 	"github.com/spf13/cobra"
 )
 
@@ -9,7 +11,7 @@ var rootCmd = &cobra.Command{
 	Short: "A powerful Markdown to PDF converter",
 	Long: `Pdfy is a CLI tool for converting Markdown files to professionally formatted PDFs.
 It supports advanced features like syntax highlighting, templates, and YAML front-matter configuration.`,
-	Version: "1.0.0",
+	Version: converter.Version,
 }
 
 func Execute() error {
@@ -20,4 +22,7 @@ func init() {
 	rootCmd.AddCommand(convertCmd)
 	rootCmd.AddCommand(batchCmd)
 	rootCmd.AddCommand(watchCmd)
+	rootCmd.AddCommand(bookCmd)
+	rootCmd.AddCommand(templatesCmd)
+	rootCmd.AddCommand(themesCmd)
 }
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/himprakashdas/pdfy/internal/converter"
+
+	"github.com/spf13/cobra"
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage HTML templates",
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the templates that can be selected with --template",
+	Long: `List the built-in templates and your own, which are looked up in the
+--template-dir directories, the .pdfy/templates directory of the project and
+pdfy/templates in your config directory.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return printResources(newLister().Templates())
+	},
+}
+
+var themesCmd = &cobra.Command{
+	Use:   "themes",
+	Short: "Manage CSS themes",
+}
+
+var themesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the themes that can be selected with --theme",
+	Long: `List the built-in themes and your own, which are looked up in the
+--template-dir directories, the .pdfy/themes directory of the project and
+pdfy/themes in your config directory.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return printResources(newLister().Themes())
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{templatesListCmd, themesListCmd} {
+		cmd.Flags().StringSliceVar(&templateDirs, "template-dir", nil,
+			"Directory searched first for templates (NAME.html) and themes (NAME.css) (repeatable)")
+	}
+	templatesCmd.AddCommand(templatesListCmd)
+	themesCmd.AddCommand(themesListCmd)
+}
+
+// newLister returns a converter that looks up templates and themes for the
+// current directory
+func newLister() *converter.Converter {
+	return converter.New(&converter.Config{TemplateDirs: templateDirs})
+}
+
+// printResources prints templates or themes with where they come from
+func printResources(resources []converter.Resource) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, resource := range resources {
+		source := resource.Path
+		if source == "" {
+			source = "built-in"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", resource.Name, source)
+	}
+	return w.Flush()
+}
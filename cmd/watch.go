@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
@@ -28,14 +30,16 @@ Examples:
 
 func init() {
 	watchCmd.Flags().StringVar(&outputDir, "output-dir", "", "Output directory for PDF files")
-	watchCmd.Flags().StringVarP(&templateName, "template", "t", "default", "Template to use")
-	watchCmd.Flags().StringVar(&cssPath, "css", "", "Custom CSS file path")
-	watchCmd.Flags().StringVar(&theme, "theme", "light", "Theme to use")
+	addConversionFlags(watchCmd)
 }
 
 func watchDirectory(cmd *cobra.Command, args []string) error {
 	watchDir := args[0]
 
+	if err := loadPasswords(); err != nil {
+		return err
+	}
+
 	// Create file watcher
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -49,6 +53,17 @@ func watchDirectory(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to watch directory: %w", err)
 	}
 
+	// Keep one browser running for the whole session
+	browser := newBrowser(1)
+	if browser != nil {
+		defer browser.Close()
+	}
+
+	// Stop cleanly on Ctrl+C so the browser is shut down
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
 	fmt.Printf("Watching %s for changes... (Press Ctrl+C to stop)\n", watchDir)
 
 	// Track recent conversions to avoid duplicate processing
@@ -75,13 +90,18 @@ func watchDirectory(cmd *cobra.Command, args []string) error {
 				fmt.Printf("Change detected: %s\n", filepath.Base(event.Name))
 
 				// Convert the file
-				if err := convertWatchedFile(event.Name); err != nil {
+				warnings, err := convertWatchedFile(event.Name, browser)
+				if err != nil {
 					log.Printf("Conversion failed for %s: %v", event.Name, err)
 				} else {
 					fmt.Printf("✓ Converted %s\n", filepath.Base(event.Name))
+					printWarnings(event.Name, warnings)
 				}
 			}
 
+		case <-interrupt:
+			return nil
+
 		case err, ok := <-watcher.Errors:
 			if !ok {
 				return nil
@@ -91,7 +111,7 @@ func watchDirectory(cmd *cobra.Command, args []string) error {
 	}
 }
 
-func convertWatchedFile(inputPath string) error {
+func convertWatchedFile(inputPath string, browser *converter.BrowserPool) ([]*converter.ConversionError, error) {
 	// Generate output path
 	var outPath string
 	if outputDir != "" {
@@ -105,14 +125,9 @@ func convertWatchedFile(inputPath string) error {
 	}
 
 	// Convert file
-	config := &converter.Config{
-		InputPath:    inputPath,
-		OutputPath:   outPath,
-		TemplateName: templateName,
-		CSSPath:      cssPath,
-		Theme:        theme,
+	conv := converter.New(newConfig(inputPath, outPath, browser))
+	if err := conv.Convert(); err != nil {
+		return nil, err
 	}
-
-	conv := converter.New(config)
-	return conv.Convert()
+	return conv.GetWarnings(), nil
 }
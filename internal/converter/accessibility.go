@@ -0,0 +1,125 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+)
+
+var htmlTagRegex = regexp.MustCompile(`<html\b[^>]*>`)
+
+var langAttrRegex = regexp.MustCompile(`\s+lang="[^"]*"`)
+
+// makeAccessible prepares a parsed document for tagged PDF output. Table
+// header cells get a scope, and images without alt text fall back to their
+// title. Images that still lack alt text and skipped heading levels are
+// reported as warnings.
+func (c *Converter) makeAccessible(doc ast.Node, source []byte) {
+	lastLevel := 0
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *extast.TableCell:
+			if n.Parent().Kind() == extast.KindTableHeader {
+				n.SetAttributeString("scope", []byte("col"))
+			}
+
+		case *ast.Image:
+			if len(bytes.TrimSpace(n.Text(source))) > 0 {
+				break
+			}
+			if title := strings.TrimSpace(string(n.Title)); title != "" {
+				n.AppendChild(n, ast.NewString([]byte(title)))
+				break
+			}
+			at, snippet := c.sourceLine(n, n.Destination, source)
+			c.warn(at, "image has no alt text", snippet)
+
+		case *ast.Heading:
+			if lastLevel > 0 && n.Level > lastLevel+1 {
+				at, snippet := c.sourceLine(n, nil, source)
+				c.warn(at, fmt.Sprintf("heading level skipped from h%d to h%d", lastLevel, n.Level), snippet)
+			}
+			lastLevel = n.Level
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// sourceLine returns the file and line number where a node appears, and
+// the text of the line. For inline nodes, the line of the enclosing block
+// that contains needle is used.
+func (c *Converter) sourceLine(n ast.Node, needle, source []byte) (lineOrigin, string) {
+	block := n
+	for block != nil && (block.Type() != ast.TypeBlock || block.Lines().Len() == 0) {
+		block = block.Parent()
+	}
+	if block == nil {
+		return lineOrigin{}, ""
+	}
+
+	lines := block.Lines()
+	segment := lines.At(0)
+	for i := 0; i < lines.Len(); i++ {
+		if s := lines.At(i); len(needle) > 0 && bytes.Contains(s.Value(source), needle) {
+			segment = s
+			break
+		}
+	}
+
+	line := bytes.Count(source[:segment.Start], []byte("\n"))
+	at := lineOrigin{file: c.config.InputPath, line: line + 1}
+	if line < len(c.lines) {
+		at = c.lines[line]
+	}
+
+	// Segments of headings start after the # marker, so show the whole line
+	start := bytes.LastIndexByte(source[:segment.Start], '\n') + 1
+	end := bytes.IndexByte(source[segment.Start:], '\n')
+	if end < 0 {
+		end = len(source)
+	} else {
+		end += segment.Start
+	}
+
+	return at, strings.TrimSpace(string(source[start:end]))
+}
+
+// warn records a problem that doesn't stop the conversion
+func (c *Converter) warn(at lineOrigin, message, snippet string) {
+	w := &ConversionError{
+		LineNumber: at.line,
+		Message:    message,
+		Snippet:    snippet,
+	}
+	if at.file != c.config.InputPath {
+		w.File = at.file
+	}
+	c.warnings = append(c.warnings, w)
+}
+
+// setHTMLLang sets the lang attribute of the document's html element
+func setHTMLLang(document, lang string) string {
+	if lang == "" {
+		return document
+	}
+
+	loc := htmlTagRegex.FindStringIndex(document)
+	if loc == nil {
+		return document
+	}
+
+	tag := langAttrRegex.ReplaceAllString(document[loc[0]:loc[1]], "")
+	tag = strings.TrimSuffix(tag, ">") + ` lang="` + html.EscapeString(lang) + `">`
+	return document[:loc[0]] + tag + document[loc[1]:]
+}
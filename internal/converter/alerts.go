@@ -0,0 +1,220 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// alertMarkerRegex matches the first line of a GitHub alert, such as
+// "[!NOTE]"
+var alertMarkerRegex = regexp.MustCompile(`(?i)^\[!(note|tip|important|warning|caution)\]$`)
+
+// alertIconAttrs are shared by the alert icons
+const alertIconAttrs = `viewBox="0 0 16 16" width="16" height="16" fill="none" stroke="currentColor" ` +
+	`stroke-width="1.5" stroke-linecap="round" stroke-linejoin="round" aria-hidden="true"`
+
+// alertType describes a kind of alert
+type alertType struct {
+	title string
+	icon  string
+}
+
+// alertTypes maps alert kinds to their title and icon. The kinds are the
+// ones GitHub supports.
+var alertTypes = map[string]alertType{
+	"note": {"Note", `<svg class="alert-icon" ` + alertIconAttrs + `>` +
+		`<circle cx="8" cy="8" r="6.5"/><path d="M8 7.5v3.5M8 5v.2"/></svg>`},
+	"tip": {"Tip", `<svg class="alert-icon" ` + alertIconAttrs + `>` +
+		`<path d="M6 14h4M6.5 12h3M8 1.8a4 4 0 0 0-2.5 7.1c.6.5 1 1.2 1 1.9v.2h3v-.2c0-.7.4-1.4 1-1.9A4 4 0 0 0 8 1.8z"/></svg>`},
+	"important": {"Important", `<svg class="alert-icon" ` + alertIconAttrs + `>` +
+		`<path d="M2 2.5h12v8.5H8l-3 3V11H2z"/><path d="M8 4.8v3M8 9.3v.2"/></svg>`},
+	"warning": {"Warning", `<svg class="alert-icon" ` + alertIconAttrs + `>` +
+		`<path d="M8 1.8 15 14H1z"/><path d="M8 6.2v3.8M8 12v.2"/></svg>`},
+	"caution": {"Caution", `<svg class="alert-icon" ` + alertIconAttrs + `>` +
+		`<path d="M5.3 1.5h5.4l3.8 3.8v5.4l-3.8 3.8H5.3l-3.8-3.8V5.3z"/><path d="M8 4.5v4.5M8 11.3v.2"/></svg>`},
+}
+
+// containerAliases maps other common admonition names in ::: containers to
+// alert kinds
+var containerAliases = map[string]string{
+	"info":      "note",
+	"hint":      "tip",
+	"danger":    "caution",
+	"error":     "caution",
+	"attention": "important",
+}
+
+// kindAlert is the node kind of alerts
+var kindAlert = ast.NewNodeKind("Alert")
+
+// alert is a callout box, written as a GitHub alert:
+//
+//	> [!WARNING]
+//	> Back up your data first.
+//
+// or as a container:
+//
+//	:::warning Before you start
+//	Back up your data first.
+//	:::
+type alert struct {
+	ast.BaseBlock
+	kind  string
+	title string
+}
+
+// Kind implements ast.Node
+func (n *alert) Kind() ast.NodeKind {
+	return kindAlert
+}
+
+// Dump implements ast.Node
+func (n *alert) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Kind": n.kind, "Title": n.title}, nil)
+}
+
+// alertExtension adds GitHub alerts and ::: containers to Markdown
+type alertExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *alertExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(&containerParser{}, 700)),
+		parser.WithASTTransformers(util.Prioritized(e, 500)),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// Transform implements parser.ASTTransformer. It turns blockquotes whose
+// first line is an alert marker into alerts.
+func (e *alertExtension) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	var quotes []*ast.Blockquote
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if quote, ok := n.(*ast.Blockquote); ok && entering {
+			quotes = append(quotes, quote)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, quote := range quotes {
+		para, ok := quote.FirstChild().(*ast.Paragraph)
+		if !ok || para.Lines().Len() == 0 {
+			continue
+		}
+		marker := para.Lines().At(0)
+		match := alertMarkerRegex.FindSubmatch(bytes.TrimSpace(marker.Value(source)))
+		if match == nil {
+			continue
+		}
+
+		// Drop the marker line from the paragraph
+		for child := para.FirstChild(); child != nil; {
+			t, ok := child.(*ast.Text)
+			if !ok || t.Segment.Start >= marker.Stop {
+				break
+			}
+			next := child.NextSibling()
+			para.RemoveChild(para, child)
+			child = next
+		}
+		if !para.HasChildren() {
+			quote.RemoveChild(quote, para)
+		}
+
+		node := &alert{kind: strings.ToLower(string(match[1]))}
+		for child := quote.FirstChild(); child != nil; {
+			next := child.NextSibling()
+			node.AppendChild(node, child)
+			child = next
+		}
+		quote.Parent().ReplaceChild(quote.Parent(), quote, node)
+	}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *alertExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindAlert, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		node := n.(*alert)
+		if !entering {
+			w.WriteString("</div>\n")
+			return ast.WalkContinue, nil
+		}
+
+		typ := alertTypes[node.kind]
+		title := node.title
+		if title == "" {
+			title = typ.title
+		}
+		fmt.Fprintf(w, "<div class=\"alert alert-%s\" role=\"note\">\n<p class=\"alert-title\">%s%s</p>\n",
+			node.kind, typ.icon, html.EscapeString(title))
+		return ast.WalkContinue, nil
+	})
+}
+
+// containerParser parses ::: containers. The kind follows the opening
+// colons and may be followed by a title.
+type containerParser struct{}
+
+// Trigger implements parser.BlockParser
+func (p *containerParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+// Open implements parser.BlockParser
+func (p *containerParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], []byte(":::")) {
+		return nil, parser.NoChildren
+	}
+
+	kind, title, _ := strings.Cut(strings.TrimSpace(string(line[pos+3:])), " ")
+	kind = strings.ToLower(kind)
+	if alias, ok := containerAliases[kind]; ok {
+		kind = alias
+	}
+	if _, ok := alertTypes[kind]; !ok {
+		return nil, parser.NoChildren
+	}
+
+	// The rest of the line is the title, not content
+	reader.Advance(lineLength(line, segment))
+	return &alert{kind: kind, title: strings.TrimSpace(title)}, parser.HasChildren
+}
+
+// Continue implements parser.BlockParser
+func (p *containerParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if w, pos := util.IndentWidth(line, reader.LineOffset()); w < 4 && string(util.TrimRightSpace(line[pos:])) == ":::" {
+		reader.Advance(lineLength(line, segment))
+		return parser.Close
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+// Close implements parser.BlockParser
+func (p *containerParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph implements parser.BlockParser
+func (p *containerParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser
+func (p *containerParser) CanAcceptIndentedLine() bool {
+	return false
+}
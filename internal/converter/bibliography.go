@@ -0,0 +1,683 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bibEntry is a work in the bibliography
+type bibEntry struct {
+	key string
+	// typ is article, book, chapter, paper, thesis, report or misc
+	typ string
+
+	authors []personName
+	editors []personName
+	// etAl is set when the author list ends with "and others"
+	etAl bool
+
+	title     string
+	container string
+	publisher string
+	place     string
+	volume    string
+	issue     string
+	pages     string
+	edition   string
+	year      string
+	genre     string
+	url       string
+	doi       string
+	note      string
+
+	// Set once the citations of the document are collected: the number in
+	// numeric style, and the letter that tells apart works by the same
+	// authors in the same year in author-year style
+	number int
+	suffix string
+}
+
+// personName is an author or editor. Organizations only have a family name.
+type personName struct {
+	family string
+	given  string
+}
+
+// loadBibliography reads the bibliography files named in the configuration.
+// BibTeX files end in .bib and CSL-JSON files in .json.
+func (c *Converter) loadBibliography() error {
+	if len(c.config.Bibliography) == 0 {
+		return nil
+	}
+
+	switch c.config.CitationStyle {
+	case "":
+		c.config.CitationStyle = AuthorYearStyle
+	case NumericStyle, AuthorYearStyle:
+	default:
+		return fmt.Errorf("unknown citation style %q, use %s or %s", c.config.CitationStyle, NumericStyle, AuthorYearStyle)
+	}
+
+	c.bibliography = map[string]*bibEntry{}
+	for _, path := range c.config.Bibliography {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read bibliography: %w", err)
+		}
+
+		var entries []*bibEntry
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".bib", ".bibtex":
+			entries, err = parseBibTeX(string(data))
+		case ".json":
+			entries, err = parseCSLJSON(data)
+		default:
+			return fmt.Errorf("unsupported bibliography format %s, use BibTeX (.bib) or CSL-JSON (.json)", path)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, e := range entries {
+			c.bibliography[e.key] = e
+		}
+	}
+
+	return nil
+}
+
+// BibTeX
+
+// bibMonths are the month macros BibTeX predefines
+var bibMonths = map[string]string{
+	"jan": "January", "feb": "February", "mar": "March", "apr": "April",
+	"may": "May", "jun": "June", "jul": "July", "aug": "August",
+	"sep": "September", "oct": "October", "nov": "November", "dec": "December",
+}
+
+// bibTypes maps BibTeX entry types to entry types
+var bibTypes = map[string]string{
+	"article":       "article",
+	"book":          "book",
+	"inbook":        "chapter",
+	"incollection":  "chapter",
+	"inproceedings": "paper",
+	"conference":    "paper",
+	"phdthesis":     "thesis",
+	"mastersthesis": "thesis",
+	"thesis":        "thesis",
+	"techreport":    "report",
+	"report":        "report",
+}
+
+// bibParser reads BibTeX
+type bibParser struct {
+	src    string
+	pos    int
+	macros map[string]string
+}
+
+// parseBibTeX parses the entries of a BibTeX file
+func parseBibTeX(src string) ([]*bibEntry, error) {
+	p := &bibParser{src: src, macros: map[string]string{}}
+	for k, v := range bibMonths {
+		p.macros[k] = v
+	}
+
+	var entries []*bibEntry
+	for {
+		// Text outside entries is a comment
+		at := strings.IndexByte(p.src[p.pos:], '@')
+		if at < 0 {
+			return entries, nil
+		}
+		p.pos += at + 1
+
+		typ := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if p.pos >= len(p.src) || (p.src[p.pos] != '{' && p.src[p.pos] != '(') {
+			continue
+		}
+		closing := byte('}')
+		if p.src[p.pos] == '(' {
+			closing = ')'
+		}
+		p.pos++
+
+		switch typ {
+		case "comment", "preamble":
+			if err := p.skipBalanced(closing); err != nil {
+				return nil, err
+			}
+		case "string":
+			name, value, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+			p.macros[name] = value
+			if err := p.expect(closing); err != nil {
+				return nil, err
+			}
+		default:
+			entry, err := p.entry(typ, closing)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+	}
+}
+
+// entry parses the key and fields of an entry
+func (p *bibParser) entry(typ string, closing byte) (*bibEntry, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] != ',' && p.src[p.pos] != closing && !isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+	key := p.src[start:p.pos]
+	if key == "" {
+		return nil, p.errorf("missing key of @%s entry", typ)
+	}
+
+	fields := map[string]string{}
+	for {
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+			p.skipSpace()
+		}
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("entry %s is not closed", key)
+		}
+		if p.src[p.pos] == closing {
+			p.pos++
+			break
+		}
+
+		name, value, err := p.field()
+		if err != nil {
+			return nil, fmt.Errorf("entry %s: %w", key, err)
+		}
+		fields[name] = value
+	}
+
+	return newBibEntry(key, typ, fields), nil
+}
+
+// field parses name = value, where the value may join strings, numbers
+// and macros with #
+func (p *bibParser) field() (string, string, error) {
+	p.skipSpace()
+	name := strings.ToLower(p.identifier())
+	if name == "" {
+		return "", "", p.errorf("expected a field name")
+	}
+	p.skipSpace()
+	if err := p.expect('='); err != nil {
+		return "", "", err
+	}
+
+	var value strings.Builder
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return "", "", p.errorf("missing value of field %s", name)
+		}
+
+		switch c := p.src[p.pos]; {
+		case c == '{' || c == '"':
+			part, err := p.delimited()
+			if err != nil {
+				return "", "", err
+			}
+			value.WriteString(part)
+		case c >= '0' && c <= '9':
+			start := p.pos
+			for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+				p.pos++
+			}
+			value.WriteString(p.src[start:p.pos])
+		default:
+			macro := p.identifier()
+			if macro == "" {
+				return "", "", p.errorf("unexpected %q in field %s", c, name)
+			}
+			value.WriteString(p.macros[strings.ToLower(macro)])
+		}
+
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == '#' {
+			p.pos++
+			continue
+		}
+		return name, value.String(), nil
+	}
+}
+
+// delimited parses a value in braces or quotes, keeping nested braces
+func (p *bibParser) delimited() (string, error) {
+	open := p.src[p.pos]
+	start := p.pos + 1
+	depth := 0
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		switch c := p.src[p.pos]; {
+		case c == '\\':
+			p.pos++
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case depth == 0 && ((open == '{' && c == '}') || (open == '"' && c == '"')):
+			p.pos++
+			return p.src[start : p.pos-1], nil
+		}
+	}
+	return "", p.errorf("value is not closed")
+}
+
+// skipBalanced skips to the end of an entry whose content isn't parsed
+func (p *bibParser) skipBalanced(closing byte) error {
+	depth := 0
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '{':
+			depth++
+		case '}', ')':
+			if depth == 0 && p.src[p.pos] == closing {
+				p.pos++
+				return nil
+			}
+			if p.src[p.pos] == '}' {
+				depth--
+			}
+		}
+	}
+	return p.errorf("entry is not closed")
+}
+
+func (p *bibParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("_-:.+/", c) >= 0) {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *bibParser) expect(c byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.src) || p.src[p.pos] != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *bibParser) skipSpace() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// errorf returns an error at the parser's line
+func (p *bibParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(p.src[:min(p.pos, len(p.src))], "\n") + 1
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// newBibEntry converts the fields of a BibTeX entry
+func newBibEntry(key, typ string, fields map[string]string) *bibEntry {
+	e := &bibEntry{
+		key:       key,
+		typ:       bibTypes[typ],
+		title:     texToText(fields["title"]),
+		container: texToText(firstOf(fields["journal"], fields["journaltitle"], fields["booktitle"], fields["howpublished"])),
+		publisher: texToText(firstOf(fields["publisher"], fields["school"], fields["institution"], fields["organization"])),
+		place:     texToText(firstOf(fields["address"], fields["location"])),
+		volume:    texToText(fields["volume"]),
+		issue:     texToText(fields["number"]),
+		pages:     texToText(fields["pages"]),
+		edition:   texToText(fields["edition"]),
+		year:      texToText(fields["year"]),
+		genre:     texToText(fields["type"]),
+		url:       strings.TrimSpace(fields["url"]),
+		doi:       strings.TrimSpace(fields["doi"]),
+		note:      texToText(fields["note"]),
+	}
+	if e.typ == "" {
+		e.typ = "misc"
+	}
+	if e.year == "" && len(fields["date"]) >= 4 {
+		e.year = fields["date"][:4]
+	}
+	if e.genre == "" {
+		switch typ {
+		case "phdthesis":
+			e.genre = "PhD thesis"
+		case "mastersthesis":
+			e.genre = "Master's thesis"
+		}
+	}
+
+	e.authors, e.etAl = parseBibNames(fields["author"])
+	e.editors, _ = parseBibNames(fields["editor"])
+	return e
+}
+
+// parseBibNames parses a list of names joined with "and". A list ending in
+// "and others" is reported as et al.
+func parseBibNames(list string) ([]personName, bool) {
+	var names []personName
+	etAl := false
+	for _, name := range splitTopLevel(strings.Join(strings.Fields(list), " "), " and ") {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+		case name == "others":
+			etAl = true
+		case strings.HasPrefix(name, "{") && strings.HasSuffix(name, "}") && len(splitTopLevel(name[1:len(name)-1], "}")) == 1:
+			// A name in braces is an organization
+			names = append(names, personName{family: texToText(name)})
+		default:
+			names = append(names, parseBibName(name))
+		}
+	}
+	return names, etAl
+}
+
+// parseBibName parses a name written "First von Last", "von Last, First"
+// or "von Last, Jr, First"
+func parseBibName(name string) personName {
+	parts := splitTopLevel(name, ",")
+	if len(parts) > 1 {
+		return personName{
+			family: texToText(parts[0]),
+			given:  texToText(parts[len(parts)-1]),
+		}
+	}
+
+	// The family name starts at the first lowercase word, such as "van",
+	// or is the last word
+	words := splitTopLevel(name, " ")
+	split := len(words) - 1
+	for i, w := range words[:len(words)-1] {
+		if i > 0 && w != "" && unicode.IsLower(rune(w[0])) {
+			split = i
+			break
+		}
+	}
+	return personName{
+		family: texToText(strings.Join(words[split:], " ")),
+		given:  texToText(strings.Join(words[:split], " ")),
+	}
+}
+
+// splitTopLevel splits s at the separators that aren't inside braces
+func splitTopLevel(s, sep string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '{':
+			depth++
+		case s[i] == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(s[i:], sep):
+			parts = append(parts, s[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// texAccents maps TeX accent commands to pairs of base and accented
+// letters, and to the combining mark used for other letters
+var texAccents = map[byte]struct {
+	letters string
+	mark    rune
+}{
+	'\'': {"aáeéiíoóuúyýAÁEÉIÍOÓUÚYÝcćCĆnńNŃsśSŚzźZŹ", '́'},
+	'`':  {"aàeèiìoòuùAÀEÈIÌOÒUÙ", '̀'},
+	'^':  {"aâeêiîoôuûAÂEÊIÎOÔUÛ", '̂'},
+	'"':  {"aäeëiïoöuüyÿAÄEËIÏOÖUÜ", '̈'},
+	'~':  {"aãnñoõAÃNÑOÕ", '̃'},
+	'=':  {"aāeēiīoōuūAĀEĒIĪOŌUŪ", '̄'},
+	'.':  {"zżZŻeėEĖ", '̇'},
+	'c':  {"cçCÇsşSŞ", '̧'},
+	'v':  {"cčCČsšSŠzžZŽrřRŘeěEĚnňNŇ", '̌'},
+	'u':  {"aăAĂgğGĞ", '̆'},
+	'H':  {"oőOŐuűUŰ", '̋'},
+	'k':  {"aąAĄeęEĘ", '̨'},
+	'r':  {"aåAÅuůUŮ", '̊'},
+}
+
+// texSymbols maps TeX commands to the characters they stand for
+var texSymbols = map[string]string{
+	"ss": "ß", "o": "ø", "O": "Ø", "l": "ł", "L": "Ł", "ae": "æ", "AE": "Æ",
+	"oe": "œ", "OE": "Œ", "aa": "å", "AA": "Å", "i": "ı", "j": "ȷ",
+	"textendash": "–", "textemdash": "—", "ldots": "…", "dots": "…",
+	"TeX": "TeX", "LaTeX": "LaTeX", "BibTeX": "BibTeX",
+}
+
+var texDashRegex = regexp.MustCompile(`-{2,3}`)
+
+// texToText turns the TeX markup in a BibTeX value into plain text
+func texToText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '{' || c == '}':
+		case c == '~':
+			b.WriteRune(' ')
+		case c != '\\' || i+1 == len(s):
+			b.WriteByte(c)
+
+		case texAccents[s[i+1]].mark != 0 && (strings.IndexByte(`'`+"`"+`^"~=.`, s[i+1]) >= 0 || !isLetter(s, i+2)):
+			// \'e, \'{e}, \c{c} or \c c
+			accent := texAccents[s[i+1]]
+			i += 2
+			// Dotless i and j, as in \'{\i}, take the accent
+			for i < len(s) && (s[i] == '{' || s[i] == ' ' || s[i] == '\\') {
+				i++
+			}
+			if i >= len(s) {
+				break
+			}
+			letter, size := utf8.DecodeRuneInString(s[i:])
+			i += size - 1
+			b.WriteString(accented(letter, accent.letters, accent.mark))
+
+		case isLetter(s, i+1):
+			// A command such as \ss or \emph, whose argument is kept
+			j := i + 1
+			for isLetter(s, j) {
+				j++
+			}
+			b.WriteString(texSymbols[s[i+1:j]])
+			for j < len(s) && s[j] == ' ' {
+				j++
+			}
+			i = j - 1
+
+		default:
+			// An escaped character such as \& or \%
+			i++
+			b.WriteByte(s[i])
+		}
+	}
+
+	text := strings.Join(strings.Fields(b.String()), " ")
+	return texDashRegex.ReplaceAllStringFunc(text, func(dash string) string {
+		if len(dash) == 3 {
+			return "—"
+		}
+		return "–"
+	})
+}
+
+// accented returns a letter with an accent, precomposed if it's one of the
+// pairs of base and accented letters
+func accented(letter rune, pairs string, mark rune) string {
+	runes := []rune(pairs)
+	for i := 0; i+1 < len(runes); i += 2 {
+		if runes[i] == letter {
+			return string(runes[i+1])
+		}
+	}
+	return string([]rune{letter, mark})
+}
+
+func isLetter(s string, i int) bool {
+	return i < len(s) && (s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z')
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// CSL-JSON
+
+// cslTypes maps CSL item types to entry types
+var cslTypes = map[string]string{
+	"article":           "article",
+	"article-journal":   "article",
+	"article-magazine":  "article",
+	"article-newspaper": "article",
+	"book":              "book",
+	"chapter":           "chapter",
+	"paper-conference":  "paper",
+	"thesis":            "thesis",
+	"report":            "report",
+}
+
+// cslItem is an item of a CSL-JSON bibliography
+type cslItem struct {
+	ID             cslString `json:"id"`
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	Author         []cslName `json:"author"`
+	Editor         []cslName `json:"editor"`
+	ContainerTitle string    `json:"container-title"`
+	Publisher      string    `json:"publisher"`
+	PublisherPlace string    `json:"publisher-place"`
+	Volume         cslString `json:"volume"`
+	Issue          cslString `json:"issue"`
+	Page           cslString `json:"page"`
+	Edition        cslString `json:"edition"`
+	Genre          string    `json:"genre"`
+	Issued         cslDate   `json:"issued"`
+	URL            string    `json:"URL"`
+	DOI            string    `json:"DOI"`
+	Note           string    `json:"note"`
+}
+
+type cslName struct {
+	Family              string `json:"family"`
+	Given               string `json:"given"`
+	NonDroppingParticle string `json:"non-dropping-particle"`
+	Literal             string `json:"literal"`
+}
+
+type cslDate struct {
+	DateParts [][]cslString `json:"date-parts"`
+	Literal   string        `json:"literal"`
+	Raw       string        `json:"raw"`
+}
+
+// cslString is a CSL field that may be written as a string or a number
+type cslString string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *cslString) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v != nil {
+		*s = cslString(fmt.Sprint(v))
+	}
+	return nil
+}
+
+// parseCSLJSON parses a CSL-JSON bibliography
+func parseCSLJSON(data []byte) ([]*bibEntry, error) {
+	var items []cslItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*bibEntry, 0, len(items))
+	for _, item := range items {
+		e := &bibEntry{
+			key:       string(item.ID),
+			typ:       cslTypes[item.Type],
+			title:     item.Title,
+			container: item.ContainerTitle,
+			publisher: item.Publisher,
+			place:     item.PublisherPlace,
+			volume:    string(item.Volume),
+			issue:     string(item.Issue),
+			pages:     strings.ReplaceAll(string(item.Page), "-", "–"),
+			edition:   string(item.Edition),
+			genre:     item.Genre,
+			url:       item.URL,
+			doi:       item.DOI,
+			note:      item.Note,
+			authors:   cslNames(item.Author),
+			editors:   cslNames(item.Editor),
+		}
+		if e.typ == "" {
+			e.typ = "misc"
+		}
+
+		switch {
+		case len(item.Issued.DateParts) > 0 && len(item.Issued.DateParts[0]) > 0:
+			e.year = string(item.Issued.DateParts[0][0])
+		case len(item.Issued.Raw) >= 4:
+			e.year = item.Issued.Raw[:4]
+		default:
+			e.year = item.Issued.Literal
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func cslNames(names []cslName) []personName {
+	result := make([]personName, 0, len(names))
+	for _, n := range names {
+		if n.Literal != "" {
+			result = append(result, personName{family: n.Literal})
+			continue
+		}
+		family := n.Family
+		if n.NonDroppingParticle != "" {
+			family = n.NonDroppingParticle + " " + family
+		}
+		result = append(result, personName{family: family, given: n.Given})
+	}
+	return result
+}
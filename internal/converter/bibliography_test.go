@@ -0,0 +1,204 @@
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseBibTeX(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []*bibEntry
+	}{
+		{
+			name: "nested braces",
+			in: `@article{knuth84,
+  author  = {Donald E. Knuth},
+  title   = {{Literate} Programming with {\TeX}},
+  journal = {The Computer Journal},
+  volume  = 27,
+  number  = {2},
+  pages   = {97--111},
+  year    = 1984
+}`,
+			want: []*bibEntry{{
+				key:       "knuth84",
+				typ:       "article",
+				authors:   []personName{{family: "Knuth", given: "Donald E."}},
+				title:     "Literate Programming with TeX",
+				container: "The Computer Journal",
+				volume:    "27",
+				issue:     "2",
+				pages:     "97–111",
+				year:      "1984",
+			}},
+		},
+		{
+			name: "string macros and concatenation",
+			in: `@string{tcj = "The Computer " # "Journal"}
+@article{k, journal = tcj # { (Oxford)}, note = "Published in " # mar, year = "1984"}`,
+			want: []*bibEntry{{
+				key:       "k",
+				typ:       "article",
+				container: "The Computer Journal (Oxford)",
+				note:      "Published in March",
+				year:      "1984",
+			}},
+		},
+		{
+			name: "comments, parentheses and quotes",
+			in: `Text outside entries is ignored.
+@comment{not @article{x, title = {X}}}
+@book(b, title = "A {"}quoted{"} title", publisher = {Addison--Wesley}, edition = {2})`,
+			want: []*bibEntry{{
+				key:       "b",
+				typ:       "book",
+				title:     `A "quoted" title`,
+				publisher: "Addison–Wesley",
+				edition:   "2",
+			}},
+		},
+		{
+			name: "name lists",
+			in:   `@misc{m, author = {Knuth, Donald E. and {Barnes and Noble} and Ludwig van Beethoven and others}}`,
+			want: []*bibEntry{{
+				key: "m",
+				typ: "misc",
+				authors: []personName{
+					{family: "Knuth", given: "Donald E."},
+					{family: "Barnes and Noble"},
+					{family: "van Beethoven", given: "Ludwig"},
+				},
+				etAl: true,
+			}},
+		},
+		{
+			name: "accents",
+			in:   `@phdthesis{t, author = {Paul Erd{\H{o}}s and Kurt G{\"o}del}, school = {Universit\"at Wien}}`,
+			want: []*bibEntry{{
+				key: "t",
+				typ: "thesis",
+				authors: []personName{
+					{family: "Erdős", given: "Paul"},
+					{family: "Gödel", given: "Kurt"},
+				},
+				publisher: "Universität Wien",
+				genre:     "PhD thesis",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBibTeX(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseBibTeX() =")
+				for _, e := range got {
+					t.Errorf("  %+v", *e)
+				}
+				t.Errorf("want")
+				for _, e := range tt.want {
+					t.Errorf("  %+v", *e)
+				}
+			}
+		})
+	}
+}
+
+func TestParseBibTeXErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"missing key", "@article{, title = {X}}", "line 1: missing key of @article entry"},
+		{"unclosed entry", "@article{a,\n  title = {X},\n", "line 3: entry a is not closed"},
+		{"unclosed value", "@article{a,\n  title = {X {Y}\n", "entry a: line 3: value is not closed"},
+		{"missing value", "@article{a, title = }", "entry a: line 1: unexpected '}' in field title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseBibTeX(tt.in)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseBibTeX() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCitations(t *testing.T) {
+	const bib = `@article{knuth84,
+  author  = {Donald E. Knuth},
+  title   = {Literate Programming},
+  journal = {The Computer Journal},
+  volume  = 27, number = 2, pages = {97--111}, year = 1984
+}
+@book{kp86a,
+  author    = {Donald E. Knuth and Michael F. Plass},
+  title     = {Breaking Paragraphs into Lines},
+  publisher = {Wiley}, address = {Chichester}, year = 1986
+}
+@book{kp86b,
+  author    = {Donald E. Knuth and Michael F. Plass},
+  title     = {Another Book},
+  publisher = {Wiley}, year = 1986
+}`
+
+	tests := []struct {
+		style string
+		in    string
+		want  []string
+	}{
+		{
+			style: NumericStyle,
+			in:    "See [@kp86a, p. 5; @knuth84] and @knuth84.",
+			want: []string{
+				`See <span class="citation">[<a href="#ref-kp86a">1</a>, p. 5], [<a href="#ref-knuth84">2</a>]</span>`,
+				`and <span class="citation">Knuth [<a href="#ref-knuth84">2</a>]</span>.`,
+				`<p class="reference" id="ref-kp86a"><span class="reference-label">[1]</span> D. E. Knuth and M. F. Plass, <i>Breaking Paragraphs into Lines</i>, Chichester: Wiley, 1986.</p>`,
+				`<p class="reference" id="ref-knuth84"><span class="reference-label">[2]</span> D. E. Knuth, “Literate Programming”, <i>The Computer Journal</i>, vol. 27, no. 2, pp. 97–111, 1984.</p>`,
+			},
+		},
+		{
+			style: AuthorYearStyle,
+			in:    "See [@kp86b; @kp86a] and [see -@knuth84, p. 99].",
+			want: []string{
+				`See <span class="citation">(<a href="#ref-kp86b">Knuth and Plass 1986a</a>; <a href="#ref-kp86a">Knuth and Plass 1986b</a>)</span>`,
+				`and <span class="citation">(see <a href="#ref-knuth84">1984</a>, p. 99)</span>.`,
+				`<p class="reference" id="ref-knuth84">Knuth, Donald E. 1984. “Literate Programming.” <i>The Computer Journal</i> 27 (2): 97–111.</p>`,
+				`<p class="reference" id="ref-kp86b">Knuth, Donald E., and Michael F. Plass. 1986a. <i>Another Book</i>. Wiley.</p>`,
+				`<p class="reference" id="ref-kp86a">Knuth, Donald E., and Michael F. Plass. 1986b. <i>Breaking Paragraphs into Lines</i>. Chichester: Wiley.</p>`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			entries, err := parseBibTeX(bib)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := New(&Config{InputPath: "doc.md", CitationStyle: tt.style})
+			c.bibliography = map[string]*bibEntry{}
+			for _, e := range entries {
+				c.bibliography[e.key] = e
+			}
+
+			got, err := c.markdownToHTML([]byte(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("missing %s in\n%s", want, got)
+				}
+			}
+		})
+	}
+}
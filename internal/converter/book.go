@@ -0,0 +1,314 @@
+package converter
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// SummaryFile lists the chapters of a book in reading order
+const SummaryFile = "SUMMARY.md"
+
+var summaryLinkRegex = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)[^)]*\)`)
+
+// chapter is one Markdown file of a book
+type chapter struct {
+	path        string
+	frontMatter *FrontMatter
+	content     []byte
+	lines       []lineOrigin
+	doc         ast.Node
+
+	// anchor is the ID of the chapter's section in the book
+	anchor string
+	// ids maps the heading IDs assigned by the parser to their IDs in the
+	// book, which differ when another chapter used the same ID first
+	ids map[string]string
+}
+
+// ConvertBook combines Markdown files, in the given order, into a single PDF
+// at the output path. The book gets one table of contents and continuous
+// page numbers, every file starts on a new page, and links between the
+// files point inside the book. The first file's front matter applies to
+// the whole book.
+func (c *Converter) ConvertBook(paths []string) error {
+	if len(paths) == 0 {
+		return errors.New("a book needs at least one chapter")
+	}
+
+	chapters := make([]*chapter, len(paths))
+	for i, path := range paths {
+		ch, err := c.readChapter(path)
+		if err != nil {
+			return err
+		}
+		ch.anchor = fmt.Sprintf("chapter-%d", i+1)
+		chapters[i] = ch
+	}
+
+	frontMatter := chapters[0].frontMatter
+	c.mergeConfigWithFrontMatter(frontMatter)
+	if err := c.loadBibliography(); err != nil {
+		return fmt.Errorf("failed to load bibliography: %w", err)
+	}
+
+	assignBookIDs(chapters)
+	for _, ch := range chapters {
+		rewriteBookLinks(ch, chapters)
+	}
+
+	// Number every chapter first, so that references can point forward
+	docs := make([]ast.Node, len(chapters))
+	for i, ch := range chapters {
+		c.inChapter(ch, func() {
+			c.numberLabels(ch.doc, ch.content)
+			c.collectCitations(ch.doc, ch.content)
+		})
+		docs[i] = ch.doc
+	}
+	c.addReferences(docs...)
+
+	var sections strings.Builder
+	for _, ch := range chapters {
+		c.chapters = append(c.chapters, ch.anchor)
+		var htmlContent string
+		var err error
+		c.inChapter(ch, func() {
+			htmlContent, err = c.renderMarkdown(ch.doc, ch.content)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to convert %s to HTML: %w", ch.path, err)
+		}
+
+		fmt.Fprintf(&sections, "<section class=\"chapter\" id=\"%s\" style=\"%s\">\n%s</section>\n", ch.anchor, c.chapterBreak(), htmlContent)
+	}
+
+	content := c.bookTOC(c.headings) + sections.String()
+	if c.config.OutlineDepth > 0 {
+		content += outlineAnchors(c.headings)
+	}
+
+	return c.writePDF(content, frontMatter)
+}
+
+// inChapter runs fn with warnings reported against a chapter's file
+func (c *Converter) inChapter(ch *chapter, fn func()) {
+	c.lines = ch.lines
+	warnings := len(c.warnings)
+
+	fn()
+
+	for _, w := range c.warnings[warnings:] {
+		if w.File == "" {
+			w.File = ch.path
+		}
+	}
+}
+
+// readChapter reads and parses a chapter file
+func (c *Converter) readChapter(path string) (*chapter, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input file: %w", err)
+	}
+	c.stats.InputSize += int64(len(content))
+
+	frontMatter, markdownContent, err := c.parseFrontMatter(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse front matter of %s: %w", path, err)
+	}
+
+	firstLine := bytes.Count(content[:len(content)-len(markdownContent)], []byte("\n")) + 1
+	markdownContent, lines, err := expandIncludes(path, markdownContent, firstLine, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to include files: %w", err)
+	}
+
+	return &chapter{
+		path:        path,
+		frontMatter: frontMatter,
+		content:     markdownContent,
+		lines:       lines,
+		doc:         parseMarkdown(markdownContent),
+	}, nil
+}
+
+// assignBookIDs makes heading IDs unique across the book. IDs already used
+// by an earlier chapter are prefixed with the chapter's anchor.
+func assignBookIDs(chapters []*chapter) {
+	used := map[string]bool{}
+	for _, ch := range chapters {
+		used[ch.anchor] = true
+	}
+
+	for _, ch := range chapters {
+		ch.ids = map[string]string{}
+
+		_ = ast.Walk(ch.doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			h, ok := n.(*ast.Heading)
+			if !ok || !entering {
+				return ast.WalkContinue, nil
+			}
+
+			value, ok := h.AttributeString("id")
+			if !ok {
+				return ast.WalkSkipChildren, nil
+			}
+			id, ok := value.([]byte)
+			if !ok {
+				return ast.WalkSkipChildren, nil
+			}
+
+			bookID := string(id)
+			for i := 1; used[bookID]; i++ {
+				bookID = fmt.Sprintf("%s-%s", ch.anchor, id)
+				if i > 1 {
+					bookID = fmt.Sprintf("%s-%d", bookID, i)
+				}
+			}
+			used[bookID] = true
+			ch.ids[string(id)] = bookID
+			h.SetAttributeString("id", []byte(bookID))
+
+			return ast.WalkSkipChildren, nil
+		})
+	}
+}
+
+// rewriteBookLinks points links to other chapters at their place in the
+// book
+func rewriteBookLinks(ch *chapter, chapters []*chapter) {
+	byPath := map[string]*chapter{}
+	for _, other := range chapters {
+		if abs, err := filepath.Abs(other.path); err == nil {
+			byPath[abs] = other
+		}
+	}
+	dir := filepath.Dir(ch.path)
+
+	_ = ast.Walk(ch.doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Link:
+			u, err := url.Parse(string(n.Destination))
+			if err != nil || u.Scheme != "" || u.Host != "" {
+				break
+			}
+
+			target := ch
+			if u.Path != "" {
+				abs, err := filepath.Abs(filepath.Join(dir, filepath.FromSlash(u.Path)))
+				if err != nil || byPath[abs] == nil {
+					break
+				}
+				target = byPath[abs]
+			}
+
+			if id, ok := target.ids[u.Fragment]; ok {
+				n.Destination = []byte("#" + id)
+			} else if u.Path != "" {
+				n.Destination = []byte("#" + target.anchor)
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// ReadSummary reads the chapters listed in a SUMMARY.md file, as used by
+// mdBook and GitBook. Chapters are the Markdown links in the file, in
+// order, with paths relative to the file. The title is the file's first
+// heading.
+func ReadSummary(path string) ([]string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	dir := filepath.Dir(path)
+	seen := map[string]bool{}
+
+	var chapters []string
+	var title string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if title == "" && strings.HasPrefix(line, "# ") {
+			title = strings.TrimSpace(strings.TrimPrefix(line, "# "))
+			continue
+		}
+
+		for _, match := range summaryLinkRegex.FindAllStringSubmatch(line, -1) {
+			u, err := url.Parse(match[1])
+			if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" {
+				continue
+			}
+
+			chapter := filepath.Join(dir, filepath.FromSlash(u.Path))
+			if !seen[chapter] {
+				seen[chapter] = true
+				chapters = append(chapters, chapter)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(chapters) == 0 {
+		return nil, "", fmt.Errorf("%s lists no chapters", path)
+	}
+
+	return chapters, title, nil
+}
+
+// SortChapters orders chapter files by the order or weight in their front
+// matter. Files without either come after the others, sorted by path.
+func SortChapters(paths []string) ([]string, error) {
+	weights := make(map[string]int, len(paths))
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read input file: %w", err)
+		}
+
+		fm, _, err := (&Converter{}).parseFrontMatter(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse front matter of %s: %w", path, err)
+		}
+
+		weights[path] = fm.Order
+		if weights[path] == 0 {
+			weights[path] = fm.Weight
+		}
+	}
+
+	sorted := append([]string{}, paths...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := weights[sorted[i]], weights[sorted[j]]
+		switch {
+		case a != 0 && b != 0 && a != b:
+			return a < b
+		case a != 0 && b == 0:
+			return true
+		case a == 0 && b != 0:
+			return false
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted, nil
+}
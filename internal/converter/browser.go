@@ -0,0 +1,241 @@
+package converter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+const (
+	// DefaultPoolSize is the number of tabs a BrowserPool keeps open
+	DefaultPoolSize = 4
+
+	// maxTabUses is how many documents a tab prints before it is recycled
+	maxTabUses = 50
+)
+
+// ErrPoolClosed is returned when a closed BrowserPool is used
+var ErrPoolClosed = errors.New("browser pool is closed")
+
+// BrowserOptions controls how a BrowserPool obtains its browser
+type BrowserOptions struct {
+	// RemoteURL is the DevTools address of a running Chrome, such as
+	// ws://127.0.0.1:9222/. When set, no browser is launched.
+	RemoteURL string
+	// ExecPath is the Chrome executable to launch
+	ExecPath string
+	// Flags are extra command line flags for Chrome, such as
+	// "disable-gpu" or "font-render-hinting=none"
+	Flags []string
+	// NoSandbox disables Chrome's sandbox, which is needed when running
+	// as root in containers
+	NoSandbox bool
+}
+
+// BrowserPool keeps a single headless Chrome instance alive and hands out
+// tabs from it, so that converting many files doesn't start a new browser
+// for every document. It is safe for concurrent use.
+type BrowserPool struct {
+	options BrowserOptions
+	slots   chan struct{}
+
+	mu            sync.Mutex
+	allocCancel   context.CancelFunc
+	browserCtx    context.Context
+	browserCancel context.CancelFunc
+	idle          []*browserTab
+	closed        bool
+}
+
+// browserTab is a single Chrome tab owned by a BrowserPool
+type browserTab struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	browser context.Context
+	uses    int
+}
+
+// NewBrowserPool creates a pool that allows up to size tabs to be used at
+// once. Chrome is started (or connected to) lazily on first use.
+func NewBrowserPool(size int, options BrowserOptions) *BrowserPool {
+	if size <= 0 {
+		size = DefaultPoolSize
+	}
+
+	return &BrowserPool{
+		options: options,
+		slots:   make(chan struct{}, size),
+	}
+}
+
+// Remote reports whether the pool uses a browser it didn't launch, which
+// can't read local files
+func (p *BrowserPool) Remote() bool {
+	return p.options.RemoteURL != ""
+}
+
+// Run executes the actions in a pooled tab with the given timeout. If the
+// browser crashed while running them, it is relaunched and the actions are
+// retried once.
+func (p *BrowserPool) Run(timeout time.Duration, actions ...chromedp.Action) error {
+	for attempt := 0; ; attempt++ {
+		tab, err := p.acquire()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(tab.ctx, timeout)
+		err = chromedp.Run(ctx, actions...)
+		cancel()
+
+		crashed := p.release(tab, err)
+		if err == nil || !crashed || attempt > 0 {
+			return err
+		}
+	}
+}
+
+// Close shuts down all tabs and the browser. The pool can't be used after
+// it has been closed.
+func (p *BrowserPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, tab := range p.idle {
+		tab.cancel()
+	}
+	p.idle = nil
+	p.shutdown()
+}
+
+// acquire waits for a free slot and returns an idle tab, opening a new one
+// (and launching the browser) if necessary
+func (p *BrowserPool) acquire() (*browserTab, error) {
+	p.slots <- struct{}{}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		<-p.slots
+		return nil, ErrPoolClosed
+	}
+
+	if err := p.ensureBrowser(); err != nil {
+		<-p.slots
+		return nil, err
+	}
+
+	// Reuse an idle tab from the current browser if there is one
+	for len(p.idle) > 0 {
+		tab := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if tab.browser == p.browserCtx && tab.ctx.Err() == nil {
+			return tab, nil
+		}
+		tab.cancel()
+	}
+
+	// Open the tab before any timeout is applied, as the tab lives as long
+	// as the context it is first run with
+	ctx, cancel := chromedp.NewContext(p.browserCtx)
+	if err := chromedp.Run(ctx); err != nil {
+		cancel()
+		<-p.slots
+		return nil, fmt.Errorf("failed to open browser tab: %w", err)
+	}
+
+	return &browserTab{ctx: ctx, cancel: cancel, browser: p.browserCtx}, nil
+}
+
+// release hands a tab back to the pool. Tabs that failed or have been used
+// too often are closed instead of being reused. It reports whether the
+// browser the tab belonged to has died.
+func (p *BrowserPool) release(tab *browserTab, runErr error) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	defer func() { <-p.slots }()
+
+	tab.uses++
+	crashed := tab.browser.Err() != nil && !p.closed
+
+	if runErr != nil || crashed || p.closed || tab.uses >= maxTabUses || tab.browser != p.browserCtx {
+		tab.cancel()
+		return crashed
+	}
+
+	p.idle = append(p.idle, tab)
+	return false
+}
+
+// ensureBrowser launches Chrome if it isn't running, or relaunches it if
+// it has exited. The caller must hold p.mu.
+func (p *BrowserPool) ensureBrowser() error {
+	if p.browserCtx != nil && p.browserCtx.Err() == nil {
+		return nil
+	}
+	p.shutdown()
+
+	allocCtx, allocCancel := p.newAllocator()
+	browserCtx, browserCancel := chromedp.NewContext(allocCtx)
+
+	// Start the browser now so launch failures are reported here
+	if err := chromedp.Run(browserCtx); err != nil {
+		browserCancel()
+		allocCancel()
+		if p.Remote() {
+			return fmt.Errorf("failed to connect to Chrome at %s: %w", p.options.RemoteURL, err)
+		}
+		return fmt.Errorf("failed to start Chrome: %w", err)
+	}
+
+	p.allocCancel = allocCancel
+	p.browserCtx = browserCtx
+	p.browserCancel = browserCancel
+	return nil
+}
+
+// newAllocator creates the allocator context that launches or connects to
+// the browser
+func (p *BrowserPool) newAllocator() (context.Context, context.CancelFunc) {
+	if p.Remote() {
+		return chromedp.NewRemoteAllocator(context.Background(), p.options.RemoteURL)
+	}
+
+	opts := append([]chromedp.ExecAllocatorOption{}, chromedp.DefaultExecAllocatorOptions[:]...)
+	if p.options.ExecPath != "" {
+		opts = append(opts, chromedp.ExecPath(p.options.ExecPath))
+	}
+	if p.options.NoSandbox {
+		opts = append(opts, chromedp.NoSandbox)
+	}
+	for _, flag := range p.options.Flags {
+		name, value, found := strings.Cut(strings.TrimLeft(flag, "-"), "=")
+		if !found {
+			opts = append(opts, chromedp.Flag(name, true))
+			continue
+		}
+		opts = append(opts, chromedp.Flag(name, value))
+	}
+
+	return chromedp.NewExecAllocator(context.Background(), opts...)
+}
+
+// shutdown stops the current browser, if any. The caller must hold p.mu.
+func (p *BrowserPool) shutdown() {
+	if p.browserCancel != nil {
+		p.browserCancel()
+	}
+	if p.allocCancel != nil {
+		p.allocCancel()
+	}
+	p.browserCtx = nil
+	p.browserCancel = nil
+	p.allocCancel = nil
+}
@@ -0,0 +1,689 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// Citation styles
+const (
+	// NumericStyle cites works by number, [1], and lists them in the order
+	// they're first cited
+	NumericStyle = "numeric"
+	// AuthorYearStyle cites works by author and year, (Knuth 1984), and
+	// lists them by author
+	AuthorYearStyle = "author-year"
+)
+
+// referencesMarker is replaced by the list of cited works. Without it, the
+// list goes at the end of the document.
+const referencesMarker = "<!-- REFERENCES -->"
+
+// citeKeyPattern matches citation keys as Pandoc does: letters, digits
+// and _, with punctuation inside
+const citeKeyPattern = `[\p{L}\p{N}_](?:[\p{L}\p{N}_:.#$%&+?<>~/-]*[\p{L}\p{N}_])?`
+
+var (
+	// citationItemRegex matches one citation in brackets, with an optional
+	// prefix, - to leave out the author, and a locator or suffix:
+	// see -@knuth84, p. 33
+	citationItemRegex = regexp.MustCompile(`^\s*(?:(.*?)\s+)?(-?)@(` + citeKeyPattern + `)(.*?)\s*$`)
+	// narrativeCitationRegex matches a citation in the text, with an
+	// optional locator: @knuth84 [p. 33]
+	narrativeCitationRegex = regexp.MustCompile(`^@(` + citeKeyPattern + `)(?: ?\[([^\[\]]*)\])?`)
+)
+
+// kindCitation is the node kind of citations
+var kindCitation = ast.NewNodeKind("Citation")
+
+// kindReferenceList is the node kind of the list of cited works
+var kindReferenceList = ast.NewNodeKind("ReferenceList")
+
+// citation cites one or more works of the bibliography, written as
+// [@knuth84; @lamport94, ch. 2], or as @knuth84 in the text
+type citation struct {
+	ast.BaseInline
+	items     []*citationItem
+	narrative bool
+	style     string
+
+	// source is the citation as written, which stays text when it isn't
+	// resolved
+	source text.Segment
+}
+
+// citationItem is one cited work of a citation
+type citationItem struct {
+	key    string
+	prefix string
+	suffix string
+	// suppressAuthor is set by -@key, which cites only the year
+	suppressAuthor bool
+	// entry is nil when the key isn't in the bibliography
+	entry *bibEntry
+}
+
+// Kind implements ast.Node
+func (n *citation) Kind() ast.NodeKind {
+	return kindCitation
+}
+
+// Dump implements ast.Node
+func (n *citation) Dump(source []byte, level int) {
+	keys := make([]string, len(n.items))
+	for i, item := range n.items {
+		keys[i] = item.key
+	}
+	ast.DumpHelper(n, source, level, map[string]string{"Keys": strings.Join(keys, ", ")}, nil)
+}
+
+// referenceList is the list of cited works
+type referenceList struct {
+	ast.BaseBlock
+	entries []*bibEntry
+	style   string
+}
+
+// Kind implements ast.Node
+func (n *referenceList) Kind() ast.NodeKind {
+	return kindReferenceList
+}
+
+// Dump implements ast.Node
+func (n *referenceList) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Style": n.style}, nil)
+}
+
+// citationExtension parses citations and renders them with the list of
+// cited works
+type citationExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *citationExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		// Brackets are tried before links, and @ after cross-references
+		parser.WithInlineParsers(
+			util.Prioritized(&bracketCitationParser{}, 150),
+			util.Prioritized(&narrativeCitationParser{}, 600),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// bracketCitationParser parses citations in brackets
+type bracketCitationParser struct{}
+
+// Trigger implements parser.InlineParser
+func (p *bracketCitationParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse implements parser.InlineParser
+func (p *bracketCitationParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	end := bytes.IndexAny(line[1:], "[]") + 1
+	if end == 0 || line[end] != ']' {
+		return nil
+	}
+
+	// [@key](url) and [@key][ref] are links
+	if end+1 < len(line) && (line[end+1] == '(' || line[end+1] == '[') {
+		return nil
+	}
+
+	var items []*citationItem
+	for _, part := range strings.Split(string(line[1:end]), ";") {
+		match := citationItemRegex.FindStringSubmatch(part)
+		if match == nil || crossRefRegex.MatchString("@"+match[3]) {
+			return nil
+		}
+		items = append(items, &citationItem{
+			key:            match[3],
+			prefix:         match[1],
+			suffix:         citationSuffix(match[4]),
+			suppressAuthor: match[2] == "-",
+		})
+	}
+
+	block.Advance(end + 1)
+	return &citation{items: items, source: text.NewSegment(segment.Start, segment.Start+end+1)}
+}
+
+// narrativeCitationParser parses citations in the text
+type narrativeCitationParser struct{}
+
+// Trigger implements parser.InlineParser
+func (p *narrativeCitationParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// Parse implements parser.InlineParser
+func (p *narrativeCitationParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	// Leave email addresses alone
+	if r := block.PrecendingCharacter(); unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return nil
+	}
+
+	line, segment := block.PeekLine()
+	match := narrativeCitationRegex.FindSubmatchIndex(line)
+	if match == nil {
+		return nil
+	}
+
+	// A link after the key isn't a locator
+	end := match[1]
+	suffix := ""
+	if match[4] >= 0 {
+		if end < len(line) && (line[end] == '(' || line[end] == '[') {
+			end = match[3]
+		} else {
+			suffix = citationSuffix(string(line[match[4]:match[5]]))
+		}
+	}
+
+	block.Advance(end)
+	return &citation{
+		items:     []*citationItem{{key: string(line[match[2]:match[3]]), suffix: suffix}},
+		narrative: true,
+		source:    text.NewSegment(segment.Start, segment.Start+end),
+	}
+}
+
+// citationSuffix returns the text after a citation key, starting with a
+// comma unless it starts with other punctuation
+func citationSuffix(suffix string) string {
+	suffix = strings.TrimSpace(suffix)
+	if suffix == "" || strings.ContainsRune(",;.:", rune(suffix[0])) {
+		return suffix
+	}
+	return ", " + suffix
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *citationExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindCitation, e.renderCitation)
+	reg.Register(kindReferenceList, e.renderReferenceList)
+}
+
+func (e *citationExtension) renderCitation(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*citation)
+	w.WriteString(`<span class="citation">`)
+
+	if node.narrative {
+		item := node.items[0]
+		if node.style == NumericStyle {
+			fmt.Fprintf(w, "%s [%s%s]", html.EscapeString(citeNames(item.entry)), citeLink(item.entry, fmt.Sprint(item.entry.number)), html.EscapeString(item.suffix))
+		} else {
+			fmt.Fprintf(w, "%s (%s%s)", html.EscapeString(citeNames(item.entry)), citeLink(item.entry, citeYear(item.entry)), html.EscapeString(item.suffix))
+		}
+		w.WriteString("</span>")
+		return ast.WalkSkipChildren, nil
+	}
+
+	parts := make([]string, len(node.items))
+	for i, item := range node.items {
+		var cite string
+		switch {
+		case item.entry == nil:
+			cite = fmt.Sprintf(`<span class="ref-missing" title="%s">%s?</span>`, html.EscapeString(item.key), html.EscapeString(item.key))
+		case node.style == NumericStyle:
+			cite = citeLink(item.entry, fmt.Sprint(item.entry.number))
+		case item.suppressAuthor:
+			cite = citeLink(item.entry, citeYear(item.entry))
+		default:
+			cite = citeLink(item.entry, html.EscapeString(citeNames(item.entry))+" "+citeYear(item.entry))
+		}
+		parts[i] = joinNonEmpty(" ", html.EscapeString(item.prefix), cite+html.EscapeString(item.suffix))
+
+		// As in IEEE style, each work gets its own brackets: [1], [2, p. 5]
+		if node.style == NumericStyle {
+			parts[i] = "[" + parts[i] + "]"
+		}
+	}
+
+	if node.style == NumericStyle {
+		w.WriteString(strings.Join(parts, ", "))
+	} else {
+		fmt.Fprintf(w, "(%s)", strings.Join(parts, "; "))
+	}
+	w.WriteString("</span>")
+	return ast.WalkSkipChildren, nil
+}
+
+func (e *citationExtension) renderReferenceList(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*referenceList)
+	fmt.Fprintf(w, "<div class=\"references references-%s\" id=\"refs\">\n", node.style)
+	for _, entry := range node.entries {
+		fmt.Fprintf(w, "<p class=\"reference\" id=\"ref-%s\">", html.EscapeString(entry.key))
+		if node.style == NumericStyle {
+			fmt.Fprintf(w, "<span class=\"reference-label\">[%d]</span> %s", entry.number, formatNumericReference(entry))
+		} else {
+			w.WriteString(formatAuthorYearReference(entry))
+		}
+		w.WriteString("</p>\n")
+	}
+	w.WriteString("</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// collectCitations links the citations of a document to the bibliography,
+// and numbers the works in the order they're first cited. Without a
+// bibliography, citations stay text. Unknown keys are reported as
+// warnings, except in the text, where @name may mean something else.
+func (c *Converter) collectCitations(doc ast.Node, source []byte) {
+	var citations []*citation
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *citation:
+			citations = append(citations, n)
+		case *ast.HTMLBlock:
+			if strings.TrimSpace(codeBlockText(n, source)) == referencesMarker {
+				c.referencesMarker = n
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, cite := range citations {
+		if c.bibliography == nil || (cite.narrative && c.bibliography[cite.items[0].key] == nil) || inLink(cite) {
+			cite.Parent().ReplaceChild(cite.Parent(), cite, ast.NewTextSegment(cite.source))
+			continue
+		}
+
+		cite.style = c.config.CitationStyle
+		for _, item := range cite.items {
+			item.entry = c.bibliography[item.key]
+			if item.entry == nil {
+				at, snippet := c.sourceLine(cite.Parent(), []byte("@"+item.key), source)
+				c.warn(at, fmt.Sprintf("unknown citation key @%s", item.key), snippet)
+				continue
+			}
+			if item.entry.number == 0 {
+				c.cited = append(c.cited, item.entry)
+				item.entry.number = len(c.cited)
+			}
+		}
+	}
+}
+
+// inLink reports whether a node is part of a link's text
+func inLink(n ast.Node) bool {
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		if _, ok := p.(*ast.Link); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// addReferences puts the list of cited works at the references marker, or
+// at the end of the last document. A References heading is added unless
+// the document already ends with a heading.
+func (c *Converter) addReferences(docs ...ast.Node) {
+	if len(c.cited) == 0 {
+		return
+	}
+	list := &referenceList{entries: c.references(), style: c.config.CitationStyle}
+
+	if marker := c.referencesMarker; marker != nil {
+		marker.Parent().ReplaceChild(marker.Parent(), marker, list)
+		return
+	}
+
+	doc := docs[len(docs)-1]
+	if _, ok := doc.LastChild().(*ast.Heading); !ok {
+		heading := ast.NewHeading(referencesLevel(docs))
+		heading.SetAttributeString("id", []byte("references"))
+		heading.AppendChild(heading, ast.NewString([]byte("References")))
+		doc.AppendChild(doc, heading)
+	}
+	doc.AppendChild(doc, list)
+}
+
+// referencesLevel returns the level of the References heading: the highest
+// level used after the first heading, which is often the title
+func referencesLevel(docs []ast.Node) int {
+	level := 0
+	first := true
+	for _, doc := range docs {
+		for n := doc.FirstChild(); n != nil; n = n.NextSibling() {
+			heading, ok := n.(*ast.Heading)
+			if !ok {
+				continue
+			}
+			if !first && (level == 0 || heading.Level < level) {
+				level = heading.Level
+			}
+			first = false
+		}
+	}
+	return max(level, 1)
+}
+
+// references returns the cited works in the order of the citation style.
+// In author-year style, works by the same authors in the same year get
+// letters: 1984a, 1984b.
+func (c *Converter) references() []*bibEntry {
+	entries := append([]*bibEntry{}, c.cited...)
+	if c.config.CitationStyle == NumericStyle {
+		return entries
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if ka, kb := strings.ToLower(sortNames(a)), strings.ToLower(sortNames(b)); ka != kb {
+			return ka < kb
+		}
+		if a.year != b.year {
+			return a.year < b.year
+		}
+		return strings.ToLower(a.title) < strings.ToLower(b.title)
+	})
+
+	for i := 0; i < len(entries); {
+		j := i + 1
+		for j < len(entries) && citeNames(entries[j]) == citeNames(entries[i]) && entries[j].year == entries[i].year {
+			j++
+		}
+		if j-i > 1 {
+			for k := i; k < j; k++ {
+				entries[k].suffix = string(rune('a' + k - i))
+			}
+		}
+		i = j
+	}
+	return entries
+}
+
+// citeLink links cited text to the work in the list of references
+func citeLink(e *bibEntry, text string) string {
+	return fmt.Sprintf(`<a href="#ref-%s">%s</a>`, html.EscapeString(e.key), text)
+}
+
+// citeNames returns the authors as cited in author-year style: Knuth,
+// Knuth and Plass, or Knuth et al.
+func citeNames(e *bibEntry) string {
+	people := creators(e)
+	switch {
+	case len(people) == 0:
+		return e.title
+	case len(people) > 2 || e.etAl:
+		return people[0].family + " et al."
+	case len(people) == 2:
+		return people[0].family + " and " + people[1].family
+	}
+	return people[0].family
+}
+
+// citeYear returns the year of a work as cited, with its letter
+func citeYear(e *bibEntry) string {
+	if e.year == "" {
+		return "n.d." + e.suffix
+	}
+	return html.EscapeString(e.year) + e.suffix
+}
+
+// sortNames returns the names that author-year references are sorted by
+func sortNames(e *bibEntry) string {
+	people := creators(e)
+	if len(people) == 0 {
+		return e.title
+	}
+	names := make([]string, len(people))
+	for i, p := range people {
+		names[i] = p.family + " " + p.given
+	}
+	return strings.Join(names, ", ")
+}
+
+// creators returns the authors of a work, or its editors if it has no
+// authors
+func creators(e *bibEntry) []personName {
+	if len(e.authors) > 0 {
+		return e.authors
+	}
+	return e.editors
+}
+
+// formatNumericReference formats a work in the style of IEEE:
+//
+//	D. E. Knuth, “Literate programming”, The Computer Journal, vol. 27, no. 2, pp. 97–111, 1984.
+func formatNumericReference(e *bibEntry) string {
+	title := quoted(e.title)
+	if e.typ == "book" {
+		title = italic(e.title)
+	}
+
+	parts := []string{listNames(creators(e), e.etAl, initialsFirst), title}
+	switch e.typ {
+	case "article":
+		parts = append(parts, italic(e.container), prefixed("vol. ", html.EscapeString(e.volume)), prefixed("no. ", html.EscapeString(e.issue)), prefixed("pp. ", html.EscapeString(e.pages)), html.EscapeString(e.year))
+	case "book":
+		parts = append(parts, edition(e), placePublisher(e), html.EscapeString(e.year))
+	case "chapter", "paper":
+		parts = append(parts, prefixed("in ", italic(e.container)), placePublisher(e), html.EscapeString(e.year), prefixed("pp. ", html.EscapeString(e.pages)))
+	case "thesis":
+		parts = append(parts, html.EscapeString(e.genre), html.EscapeString(e.publisher), html.EscapeString(e.place), html.EscapeString(e.year))
+	case "report":
+		parts = append(parts, html.EscapeString(e.publisher), html.EscapeString(e.place), prefixed("Tech. Rep. ", html.EscapeString(e.issue)), html.EscapeString(e.year))
+	default:
+		parts = append(parts, italic(e.container), html.EscapeString(e.publisher), html.EscapeString(e.year))
+	}
+
+	return joinNonEmpty(" ", sentence(joinNonEmpty(", ", parts...)), sentence(html.EscapeString(e.note)), referenceLink(e))
+}
+
+// formatAuthorYearReference formats a work in the style of the Chicago
+// author-date system:
+//
+//	Knuth, Donald E. 1984. “Literate Programming.” The Computer Journal 27 (2): 97–111.
+func formatAuthorYearReference(e *bibEntry) string {
+	title := quotedSentence(e.title)
+	if e.typ == "book" {
+		title = sentence(italic(e.title))
+	}
+
+	// Works without authors or editors are listed by title
+	var parts []string
+	if names := listNames(creators(e), e.etAl, familyFirst); names != "" {
+		parts = []string{sentence(names), sentence(citeYear(e)), title}
+	} else {
+		parts = []string{title, sentence(citeYear(e))}
+	}
+
+	switch e.typ {
+	case "article":
+		parts = append(parts, sentence(joinNonEmpty(": ", joinNonEmpty(" ", italic(e.container), html.EscapeString(e.volume), prefixed("(", suffixed(html.EscapeString(e.issue), ")"))), html.EscapeString(e.pages))))
+	case "book":
+		parts = append(parts, sentence(edition(e)), sentence(placePublisher(e)))
+	case "chapter", "paper":
+		editors := ""
+		if len(e.authors) > 0 {
+			editors = prefixed("edited by ", listNames(e.editors, false, givenFirst))
+		}
+		parts = append(parts, sentence(joinNonEmpty(", ", prefixed("In ", italic(e.container)), editors, html.EscapeString(e.pages))), sentence(placePublisher(e)))
+	case "thesis":
+		parts = append(parts, sentence(joinNonEmpty(", ", html.EscapeString(e.genre), html.EscapeString(e.publisher))))
+	case "report":
+		parts = append(parts, sentence(joinNonEmpty(" ", "Technical report", html.EscapeString(e.issue))), sentence(placePublisher(e)))
+	default:
+		parts = append(parts, sentence(italic(e.container)), sentence(html.EscapeString(e.publisher)))
+	}
+
+	parts = append(parts, sentence(html.EscapeString(e.note)), referenceLink(e))
+	return joinNonEmpty(" ", parts...)
+}
+
+// Name orders of the lists of names in references
+const (
+	initialsFirst = iota // D. E. Knuth
+	familyFirst          // Knuth, Donald E., the first name only
+	givenFirst           // Donald E. Knuth
+)
+
+// listNames joins names as "A and B" or "A, B, and C"
+func listNames(people []personName, etAl bool, order int) string {
+	names := make([]string, len(people))
+	for i, p := range people {
+		switch {
+		case p.given == "":
+			names[i] = p.family
+		case order == initialsFirst:
+			names[i] = initials(p.given) + " " + p.family
+		case order == familyFirst && i == 0:
+			names[i] = p.family + ", " + p.given
+		default:
+			names[i] = p.given + " " + p.family
+		}
+	}
+
+	var list string
+	switch {
+	case len(names) == 0:
+		return ""
+	case etAl:
+		list = strings.Join(names, ", ") + " et al."
+	case len(names) == 1:
+		list = names[0]
+	case len(names) == 2 && order != familyFirst:
+		list = names[0] + " and " + names[1]
+	default:
+		list = strings.Join(names[:len(names)-1], ", ") + ", and " + names[len(names)-1]
+	}
+	return html.EscapeString(list)
+}
+
+// initials abbreviates given names: Donald Ervin becomes D. E., and
+// Jean-Paul becomes J.-P.
+func initials(given string) string {
+	words := strings.Fields(given)
+	for i, word := range words {
+		parts := strings.Split(word, "-")
+		for j, part := range parts {
+			if r := []rune(part); len(r) > 0 {
+				parts[j] = string(r[0]) + "."
+			}
+		}
+		words[i] = strings.Join(parts, "-")
+	}
+	return strings.Join(words, " ")
+}
+
+// edition returns the edition of a book, such as "2nd ed."
+func edition(e *bibEntry) string {
+	n, err := strconv.Atoi(e.edition)
+	switch {
+	case e.edition == "":
+		return ""
+	case err != nil:
+		return html.EscapeString(e.edition) + " ed."
+	case n%10 == 1 && n%100 != 11:
+		return fmt.Sprintf("%dst ed.", n)
+	case n%10 == 2 && n%100 != 12:
+		return fmt.Sprintf("%dnd ed.", n)
+	case n%10 == 3 && n%100 != 13:
+		return fmt.Sprintf("%drd ed.", n)
+	}
+	return fmt.Sprintf("%dth ed.", n)
+}
+
+// placePublisher returns "Place: Publisher"
+func placePublisher(e *bibEntry) string {
+	return joinNonEmpty(": ", html.EscapeString(e.place), html.EscapeString(e.publisher))
+}
+
+// referenceLink links to the DOI of a work, or else its URL
+func referenceLink(e *bibEntry) string {
+	url := e.url
+	if e.doi != "" {
+		url = "https://doi.org/" + strings.TrimPrefix(e.doi, "https://doi.org/")
+	}
+	if url == "" {
+		return ""
+	}
+	url = html.EscapeString(url)
+	return fmt.Sprintf(`<a href="%s">%s</a>`, url, url)
+}
+
+func italic(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "<i>" + html.EscapeString(s) + "</i>"
+}
+
+func quoted(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "“" + html.EscapeString(s) + "”"
+}
+
+// quotedSentence quotes a title with the period inside the quotes
+func quotedSentence(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "“" + sentence(html.EscapeString(s)) + "”"
+}
+
+// sentence ends s with a period unless it already ends with punctuation
+func sentence(s string) string {
+	if s == "" || strings.ContainsAny(s[len(s)-1:], ".?!") {
+		return s
+	}
+	return s + "."
+}
+
+// prefixed returns prefix and value, or "" when there's no value
+func prefixed(prefix, value string) string {
+	if value == "" {
+		return ""
+	}
+	return prefix + value
+}
+
+// suffixed returns value and suffix, or "" when there's no value
+func suffixed(value, suffix string) string {
+	if value == "" {
+		return ""
+	}
+	return value + suffix
+}
+
+// joinNonEmpty joins the values that aren't empty
+func joinNonEmpty(sep string, values ...string) string {
+	var nonEmpty []string
+	for _, v := range values {
+		if v != "" {
+			nonEmpty = append(nonEmpty, v)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
@@ -2,9 +2,15 @@ package converter
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
+// Version is the pdfy version
+const Version = "1.0.0"
+
 // Config holds the configuration for the conversion process
 type Config struct {
 	InputPath    string
@@ -12,18 +18,226 @@ type Config struct {
 	TemplateName string
 	CSSPath      string
 	Theme        string
+	RendererName string
+
+	// TemplateName and Theme may be file paths, or names looked up in
+	// TemplateDirs, the project's .pdfy/templates and .pdfy/themes
+	// directories, pdfy's directory in the user's config directory, and
+	// the built-in templates and themes. TemplateDirs hold templates as
+	// NAME.html and themes as NAME.css.
+	TemplateDirs []string
+
+	// PaperSize is a preset such as "A4" or "Letter", or a custom size
+	// such as "210mm x 297mm". Defaults to A4.
+	PaperSize string
+	Landscape bool
+
+	// Margin sets all margins using CSS shorthand, e.g. "20mm" or
+	// "1in 0.75in". The per-side margins override it.
+	Margin       string
+	MarginTop    string
+	MarginBottom string
+	MarginLeft   string
+	MarginRight  string
+
+	// Scale zooms the page content, between 0.1 and 2
+	Scale float64
+
+	// PageRanges selects the pages to print, such as "1-5, 8"
+	PageRanges string
+
+	// PreferCSSPageSize lets an @page size in the CSS override PaperSize
+	PreferCSSPageSize bool
+
+	// Header and Footer are printed on every page, replacing the ones
+	// defined by the template. They may contain HTML and the tokens
+	// {{page}}, {{pages}}, {{title}}, {{date}} and {{author}}. Use "none"
+	// to remove the template's header or footer.
+	Header string
+	Footer string
+
+	// Document metadata written to the PDF. These override the values
+	// from the front matter.
+	Title    string
+	Author   string
+	Subject  string
+	Keywords []string
+	Language string
+
+	// OutlineDepth is the deepest heading level, from 1 to 6, that gets a
+	// bookmark in the PDF outline. 0 disables the outline.
+	OutlineDepth int
+
+	// TOC adds a table of contents at the start of the document, unless
+	// it has a <!-- TOC --> marker, which is always replaced
+	TOC bool
+	// TOCDepth is the deepest heading level listed, DefaultTOCDepth when 0
+	TOCDepth int
+	TOCTitle string
+	// TOCPageNumbers renders the document twice to add the page number of
+	// each entry
+	TOCPageNumbers bool
+
+	// Tagged produces a tagged, accessible PDF and checks the document for
+	// accessibility problems, which are reported as warnings
+	Tagged bool
+
+	// EmbedImages inlines local images as data URLs, so that the HTML
+	// doesn't refer to other files
+	EmbedImages bool
+
+	// RawHTML keeps the HTML written in Markdown, filtered through an
+	// allowlist of tags and attributes that HTML extends. Otherwise only
+	// comments are kept.
+	RawHTML bool
+	HTML    HTMLOptions
+
+	// PageBreakBefore lists the heading levels, such as "h1" and "h2",
+	// that start a new page
+	PageBreakBefore []string
+
+	// OddPageChapters starts chapters, which are the files of a book or
+	// the level 1 headings of a document, on right-hand pages
+	OddPageChapters bool
+
+	// Cover adds a cover page showing the title, subtitle, author, date,
+	// version, organization, logo and cover image from the front matter.
+	// It is on when the front matter sets any of subtitle, organization,
+	// logo or cover_image, unless it sets cover: false.
+	Cover bool
+
+	// Bibliography lists the BibTeX or CSL-JSON files that [@key]
+	// citations refer to
+	Bibliography []string
+
+	// CitationStyle is NumericStyle or AuthorYearStyle, the default
+	CitationStyle string
+
+	// Watermark is printed across every page. Documents with "status:
+	// draft" in their front matter get a DRAFT watermark by default.
+	Watermark *WatermarkOptions
+
+	// Encryption protects the PDF with passwords and restricts what
+	// readers may do with it. When nil, the PDF isn't encrypted.
+	Encryption *EncryptionOptions
+
+	// Renderer produces the PDF. When nil, the renderer named by
+	// RendererName is used.
+	Renderer Renderer
+
+	// Browser is a shared Chrome instance used for rendering. When nil, a
+	// browser is started and stopped for this conversion only.
+	Browser *BrowserPool
+
+	// Chrome configures the browser started when Browser is nil
+	Chrome BrowserOptions
 }
 
 // FrontMatter represents YAML front matter configuration
 type FrontMatter struct {
 	Title    string `yaml:"title"`
+	Author   string `yaml:"author"`
+	Date     string `yaml:"date"`
+	Subject  string `yaml:"subject"`
+	Keywords List   `yaml:"keywords"`
+	Language string `yaml:"language"`
 	Theme    string `yaml:"theme"`
 	Template string `yaml:"template"`
 	CSS      string `yaml:"css"`
+	Header   string `yaml:"header"`
+	Footer   string `yaml:"footer"`
+
+	// Cover is a pointer so that false can turn off the cover page the
+	// other cover fields add. Logo and CoverImage paths are relative to
+	// the Markdown file.
+	Cover        *bool  `yaml:"cover"`
+	Subtitle     string `yaml:"subtitle"`
+	Version      string `yaml:"version"`
+	Organization string `yaml:"organization"`
+	Logo         string `yaml:"logo"`
+	CoverImage   string `yaml:"cover_image"`
+
+	PaperSize    string  `yaml:"paper_size"`
+	Orientation  string  `yaml:"orientation"`
+	Margin       string  `yaml:"margin"`
+	MarginTop    string  `yaml:"margin_top"`
+	MarginBottom string  `yaml:"margin_bottom"`
+	MarginLeft   string  `yaml:"margin_left"`
+	MarginRight  string  `yaml:"margin_right"`
+	Scale        float64 `yaml:"scale"`
+	PageRanges   string  `yaml:"page_ranges"`
+
+	// OutlineDepth is a pointer so that 0 can disable the outline
+	OutlineDepth *int `yaml:"outline_depth"`
+	Accessible   bool `yaml:"accessible"`
+	EmbedImages  bool `yaml:"embed_images"`
+
+	TOC            bool   `yaml:"toc"`
+	TOCDepth       int    `yaml:"toc_depth"`
+	TOCTitle       string `yaml:"toc_title"`
+	TOCPageNumbers bool   `yaml:"toc_page_numbers"`
+
+	RawHTML bool         `yaml:"raw_html"`
+	HTML    *HTMLOptions `yaml:"html"`
+
+	PageBreakBefore List `yaml:"page_break_before"`
+	OddPageChapters bool `yaml:"odd_page_chapters"`
+
+	Watermark *WatermarkOptions `yaml:"watermark"`
+	Status    string            `yaml:"status"`
+
+	// Bibliography paths are relative to the Markdown file
+	Bibliography  List   `yaml:"bibliography"`
+	CitationStyle string `yaml:"citation_style"`
+
+	// Order or Weight sorts the chapters of a book
+	Order  int `yaml:"order"`
+	Weight int `yaml:"weight"`
+
+	// Meta holds every field by its key, including custom ones, for
+	// templates
+	Meta map[string]any `yaml:"-"`
+}
+
+// List is a front matter field that may be written either as a YAML
+// sequence or as a comma separated string
+type List []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *List) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		var items []string
+		if err := value.Decode(&items); err != nil {
+			return err
+		}
+		*l = items
+		return nil
+	}
+
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	*l = splitList(s)
+	return nil
+}
+
+// splitList splits a comma separated string, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // ConversionError represents an error during conversion
 type ConversionError struct {
+	// File is set when the error is in another file than the input, such
+	// as a chapter of a book
+	File       string
 	LineNumber int
 	Message    string
 	Snippet    string
@@ -31,6 +245,9 @@ type ConversionError struct {
 }
 
 func (e *ConversionError) Error() string {
+	if e.File != "" && e.LineNumber > 0 {
+		return fmt.Sprintf("%s: line %d: %s - %s", e.File, e.LineNumber, e.Message, e.Snippet)
+	}
 	if e.LineNumber > 0 {
 		return fmt.Sprintf("line %d: %s - %s", e.LineNumber, e.Message, e.Snippet)
 	}
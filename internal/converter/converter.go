@@ -2,21 +2,19 @@ package converter
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"time"
 
-	"github.com/chromedp/cdproto/page"
-	"github.com/chromedp/chromedp"
 	"github.com/yuin/goldmark"
 	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
 	"gopkg.in/yaml.v3"
 )
 
@@ -24,6 +22,40 @@ import (
 type Converter struct {
 	config *Config
 	stats  *ConversionStats
+
+	// Running header and footer printed on every page
+	header string
+	footer string
+
+	// Headings of the document, used for the PDF outline, and the number
+	// of words in it
+	headings []heading
+	words    int
+
+	// Whether the document contains math for KaTeX to render
+	math bool
+
+	// Numbered figures, tables and equations by label
+	labels    map[string]*label
+	figures   []*label
+	tables    []*label
+	equations int
+
+	// Works of the bibliography by key, those cited in the order they're
+	// first cited, and the marker the list of references replaces
+	bibliography     map[string]*bibEntry
+	cited            []*bibEntry
+	referencesMarker ast.Node
+
+	// IDs of the elements that start chapters, which start right-hand
+	// pages when OddPageChapters is set
+	chapters []string
+
+	// Where each line of the markdown content was read from
+	lines []lineOrigin
+
+	// Problems found that didn't stop the conversion
+	warnings []*ConversionError
 }
 
 // New creates a new converter instance
@@ -51,9 +83,19 @@ func (c *Converter) Convert() error {
 	if err != nil {
 		return fmt.Errorf("failed to parse front matter: %w", err)
 	}
+	firstLine := bytes.Count(content[:len(content)-len(markdownContent)], []byte("\n")) + 1
+
+	// Splice in included files
+	markdownContent, c.lines, err = expandIncludes(c.config.InputPath, markdownContent, firstLine, nil)
+	if err != nil {
+		return fmt.Errorf("failed to include files: %w", err)
+	}
 
 	// Merge configuration with front matter
 	c.mergeConfigWithFrontMatter(frontMatter)
+	if err := c.loadBibliography(); err != nil {
+		return fmt.Errorf("failed to load bibliography: %w", err)
+	}
 
 	// Convert markdown to HTML
 	htmlContent, err := c.markdownToHTML(markdownContent)
@@ -61,19 +103,66 @@ func (c *Converter) Convert() error {
 		return fmt.Errorf("failed to convert markdown to HTML: %w", err)
 	}
 
+	return c.writePDF(htmlContent, frontMatter)
+}
+
+// writePDF applies the template to the HTML content and writes the PDF to
+// the output path
+func (c *Converter) writePDF(htmlContent string, frontMatter *FrontMatter) error {
+	// Chapters are found in the PDF by their named destinations
+	if c.config.OddPageChapters {
+		htmlContent += chapterAnchors(c.chapters)
+	}
+
 	// Apply template and styling
-	styledHTML, err := c.applyTemplate(htmlContent, frontMatter)
+	styledHTML, coverHTML, err := c.applyTemplate(htmlContent, frontMatter)
 	if err != nil {
 		return fmt.Errorf("failed to apply template: %w", err)
 	}
 
 	// Convert HTML to PDF
-	err = c.htmlToPDF(styledHTML)
+	pdf, err := c.htmlToPDF(styledHTML)
 	if err != nil {
 		return fmt.Errorf("failed to convert HTML to PDF: %w", err)
 	}
+	if c.config.OddPageChapters {
+		styledHTML, pdf, err = c.padChapters(styledHTML, pdf)
+		if err != nil {
+			return fmt.Errorf("failed to start chapters on right-hand pages: %w", err)
+		}
+	}
+	if c.config.TOCPageNumbers {
+		pdf, err = c.numberTOC(styledHTML, pdf)
+		if err != nil {
+			return fmt.Errorf("failed to number the table of contents: %w", err)
+		}
+	}
+
+	// The cover is rendered on its own, so that it has no margins and
+	// isn't counted in the page numbers
+	if coverHTML != "" {
+		cover, err := c.coverPDF(coverHTML)
+		if err != nil {
+			return fmt.Errorf("failed to render the cover: %w", err)
+		}
+		if pdf, err = addCover(pdf, cover); err != nil {
+			return fmt.Errorf("failed to add the cover: %w", err)
+		}
+	}
+
+	// Add metadata and other finishing touches
+	pdf, err = c.postProcess(pdf, frontMatter)
+	if err != nil {
+		return fmt.Errorf("failed to post-process PDF: %w", err)
+	}
+
+	// Write PDF to output file
+	if err := os.WriteFile(c.config.OutputPath, pdf, 0o644); err != nil {
+		return fmt.Errorf("failed to write PDF file: %w", err)
+	}
 
 	// Update stats
+	c.stats.OutputSize = int64(len(pdf))
 	c.stats.EndTime = time.Now()
 	c.stats.ProcessingMS = c.stats.EndTime.Sub(c.stats.StartTime).Milliseconds()
 
@@ -126,6 +215,9 @@ func (c *Converter) parseFrontMatter(content []byte) (*FrontMatter, []byte, erro
 		if err := yaml.Unmarshal([]byte(yamlContent), frontMatter); err != nil {
 			return nil, nil, fmt.Errorf("invalid YAML front matter: %w", err)
 		}
+		if err := yaml.Unmarshal([]byte(yamlContent), &frontMatter.Meta); err != nil {
+			return nil, nil, fmt.Errorf("invalid YAML front matter: %w", err)
+		}
 	}
 
 	markdownContent := strings.Join(contentLines, "\n")
@@ -134,27 +226,199 @@ func (c *Converter) parseFrontMatter(content []byte) (*FrontMatter, []byte, erro
 
 // mergeConfigWithFrontMatter merges front matter settings with config
 func (c *Converter) mergeConfigWithFrontMatter(fm *FrontMatter) {
+	// Template and theme paths in the front matter are relative to the
+	// Markdown file
 	if fm.Theme != "" {
 		c.config.Theme = fm.Theme
+		if isResourcePath(fm.Theme, themeKind) {
+			c.config.Theme = resolveInclude(filepath.Dir(c.config.InputPath), fm.Theme)
+		}
 	}
 	if fm.Template != "" {
 		c.config.TemplateName = fm.Template
+		if isResourcePath(fm.Template, templateKind) {
+			c.config.TemplateName = resolveInclude(filepath.Dir(c.config.InputPath), fm.Template)
+		}
 	}
 	if fm.CSS != "" {
 		c.config.CSSPath = fm.CSS
 	}
+	if fm.Header != "" {
+		c.config.Header = fm.Header
+	}
+	if fm.Footer != "" {
+		c.config.Footer = fm.Footer
+	}
+
+	// Metadata from the command line overrides the front matter
+	if c.config.Title != "" {
+		fm.Title = c.config.Title
+	}
+	if c.config.Author != "" {
+		fm.Author = c.config.Author
+	}
+	if c.config.Subject != "" {
+		fm.Subject = c.config.Subject
+	}
+	if len(c.config.Keywords) > 0 {
+		fm.Keywords = c.config.Keywords
+	}
+	if c.config.Language != "" {
+		fm.Language = c.config.Language
+	}
+
+	// So do the bibliography and citation style. Bibliography paths in the
+	// front matter are relative to the Markdown file.
+	if len(c.config.Bibliography) == 0 {
+		for _, path := range fm.Bibliography {
+			c.config.Bibliography = append(c.config.Bibliography, resolveInclude(filepath.Dir(c.config.InputPath), path))
+		}
+	}
+	if c.config.CitationStyle == "" {
+		c.config.CitationStyle = fm.CitationStyle
+	}
+
+	// Page layout
+	if fm.PaperSize != "" {
+		c.config.PaperSize = fm.PaperSize
+	}
+	switch strings.ToLower(fm.Orientation) {
+	case "landscape":
+		c.config.Landscape = true
+	case "portrait":
+		c.config.Landscape = false
+	}
+	if fm.Margin != "" {
+		// A margin in the front matter replaces every margin set on the
+		// command line
+		c.config.Margin = fm.Margin
+		c.config.MarginTop = ""
+		c.config.MarginBottom = ""
+		c.config.MarginLeft = ""
+		c.config.MarginRight = ""
+	}
+	if fm.MarginTop != "" {
+		c.config.MarginTop = fm.MarginTop
+	}
+	if fm.MarginBottom != "" {
+		c.config.MarginBottom = fm.MarginBottom
+	}
+	if fm.MarginLeft != "" {
+		c.config.MarginLeft = fm.MarginLeft
+	}
+	if fm.MarginRight != "" {
+		c.config.MarginRight = fm.MarginRight
+	}
+	if fm.Scale != 0 {
+		c.config.Scale = fm.Scale
+	}
+	if fm.PageRanges != "" {
+		c.config.PageRanges = fm.PageRanges
+	}
+
+	// Watermark options from the front matter override individual options
+	// from the command line. A watermark image in the front matter is
+	// relative to the Markdown file.
+	if fm.Watermark != nil || strings.EqualFold(fm.Status, "draft") {
+		var wm WatermarkOptions
+		if c.config.Watermark != nil {
+			wm = *c.config.Watermark
+		}
+		if fm.Watermark != nil {
+			fmWatermark := *fm.Watermark
+			if fmWatermark.Image != "" {
+				fmWatermark.Image = resolveInclude(filepath.Dir(c.config.InputPath), fmWatermark.Image)
+			}
+			wm.merge(&fmWatermark)
+		}
+		if strings.EqualFold(fm.Status, "draft") && wm.Text == "" && wm.Image == "" {
+			wm.Text = draftWatermark
+		}
+		c.config.Watermark = &wm
+	}
+
+	if fm.Accessible {
+		c.config.Tagged = true
+	}
+	if fm.EmbedImages {
+		c.config.EmbedImages = true
+	}
+	if fm.RawHTML {
+		c.config.RawHTML = true
+	}
+	if fm.HTML != nil {
+		c.config.HTML.merge(fm.HTML)
+	}
+	// The technical template always has a table of contents
+	if fm.TOC || c.config.TemplateName == technicalTemplate {
+		c.config.TOC = true
+	}
+	if fm.TOCDepth != 0 {
+		c.config.TOCDepth = fm.TOCDepth
+	}
+	if fm.TOCTitle != "" {
+		c.config.TOCTitle = fm.TOCTitle
+	}
+	if fm.TOCPageNumbers {
+		c.config.TOCPageNumbers = true
+	}
+	// Page breaks from the command line override the front matter
+	if len(c.config.PageBreakBefore) == 0 {
+		c.config.PageBreakBefore = fm.PageBreakBefore
+	}
+	if fm.OddPageChapters {
+		c.config.OddPageChapters = true
+	}
+	if fm.Cover != nil {
+		c.config.Cover = c.config.Cover || *fm.Cover
+	} else if fm.Subtitle != "" || fm.Organization != "" || fm.Logo != "" || fm.CoverImage != "" {
+		c.config.Cover = true
+	}
+	if fm.OutlineDepth != nil {
+		c.config.OutlineDepth = *fm.OutlineDepth
+	}
 }
 
 // markdownToHTML converts markdown content to HTML
 func (c *Converter) markdownToHTML(content []byte) (string, error) {
+	doc := parseMarkdown(content)
+	c.numberLabels(doc, content)
+	c.collectCitations(doc, content)
+	c.addReferences(doc)
+	c.insertTOC(doc, content)
+
+	htmlContent, err := c.renderMarkdown(doc, content)
+	if err != nil {
+		return "", err
+	}
+
+	// Link to the headings so that the outline can point at them
+	if c.config.OutlineDepth > 0 {
+		htmlContent += outlineAnchors(c.headings)
+	}
+
+	return htmlContent, nil
+}
+
+// newMarkdown creates the Markdown parser and renderer, rendering raw HTML
+// with the given extension
+func newMarkdown(rawHTML *rawHTMLExtension) goldmark.Markdown {
 	// Configure goldmark with extensions
-	md := goldmark.New(
+	return goldmark.New(
 		goldmark.WithExtensions(
 			extension.GFM,            // GitHub Flavored Markdown
 			extension.Table,          // Tables
 			extension.Strikethrough,  // Strikethrough
 			extension.TaskList,       // Task lists
 			extension.DefinitionList, // Definition lists
+			&mathExtension{},         // TeX math
+			&diagramExtension{},      // Mermaid and Graphviz diagrams
+			&alertExtension{},        // GitHub alerts and ::: containers
+			&crossRefExtension{},     // Figure, table and equation references
+			&citationExtension{},     // Citations
+			&pageBreakExtension{},    // Page breaks
+			rawHTML,                  // Sanitized raw HTML
+			&tocExtension{},          // Table of contents
 			highlighting.NewHighlighting( // Syntax highlighting
 				highlighting.WithStyle("github"),
 				highlighting.WithGuessLanguage(true),
@@ -168,80 +432,74 @@ func (c *Converter) markdownToHTML(content []byte) (string, error) {
 			html.WithXHTML(),
 		),
 	)
-
-	var buf bytes.Buffer
-	if err := md.Convert(content, &buf); err != nil {
-		return "", fmt.Errorf("markdown conversion failed: %w", err)
-	}
-
-	htmlContent := buf.String()
-
-	// Process table of contents if requested
-	htmlContent = c.processTableOfContents(htmlContent)
-
-	return htmlContent, nil
 }
 
-// processTableOfContents generates and inserts table of contents
-func (c *Converter) processTableOfContents(html string) string {
-	if !strings.Contains(html, "<!-- TOC -->") {
-		return html
-	}
+// parseMarkdown parses markdown content into a document tree
+func parseMarkdown(content []byte) ast.Node {
+	return newMarkdown(&rawHTMLExtension{}).Parser().Parse(text.NewReader(content))
+}
 
-	// Extract headings
-	headingRegex := regexp.MustCompile(`<h([1-6])[^>]*id="([^"]*)"[^>]*>([^<]+)</h[1-6]>`)
-	matches := headingRegex.FindAllStringSubmatch(html, -1)
+// renderMarkdown renders a parsed document to HTML and records its headings
+func (c *Converter) renderMarkdown(doc ast.Node, content []byte) (string, error) {
+	c.headings = append(c.headings, collectHeadings(doc, content)...)
+	c.words += countWords(doc, content)
+	c.math = c.math || hasMath(doc)
+	c.renderDiagrams(doc, content)
+	c.resolveReferences(doc, content)
 
-	if len(matches) == 0 {
-		return strings.ReplaceAll(html, "<!-- TOC -->", "")
+	if c.config.Tagged {
+		c.makeAccessible(doc, content)
 	}
-
-	// Build TOC
-	var tocBuilder strings.Builder
-	tocBuilder.WriteString("<div class=\"toc\">\n<h2>Table of Contents</h2>\n<ul>\n")
-
-	for _, match := range matches {
-		level := match[1]
-		id := match[2]
-		title := strings.TrimSpace(match[3])
-
-		// Create appropriate indentation
-		indent := ""
-		if level != "1" {
-			for i := 1; i < len(level); i++ {
-				indent += "  "
-			}
-		}
-
-		tocBuilder.WriteString(fmt.Sprintf("%s<li><a href=\"#%s\">%s</a></li>\n", indent, id, title))
+	c.resolveResources(doc, content)
+	if err := c.breakPages(doc); err != nil {
+		return "", err
 	}
 
-	tocBuilder.WriteString("</ul>\n</div>\n")
+	var buf bytes.Buffer
+	if err := newMarkdown(c.rawHTML(content)).Renderer().Render(&buf, content, doc); err != nil {
+		return "", fmt.Errorf("markdown conversion failed: %w", err)
+	}
 
-	return strings.ReplaceAll(html, "<!-- TOC -->", tocBuilder.String())
+	return buf.String(), nil
 }
 
 // applyTemplate applies the template and styling to HTML content
-func (c *Converter) applyTemplate(content string, frontMatter *FrontMatter) (string, error) {
+func (c *Converter) applyTemplate(content string, frontMatter *FrontMatter) (string, string, error) {
 	// Load template
-	template, err := c.loadTemplate()
+	tmpl, err := c.loadTemplate()
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
+	// Running headers and footers are printed by the renderer rather than
+	// being part of the page
+	tmpl, header, footer := extractHeaderFooter(tmpl)
+	c.setHeaderFooter(header, footer, frontMatter)
+
 	// Load CSS
 	css, err := c.loadCSS()
 	if err != nil {
-		return "", err
+		return "", "", err
+	}
+
+	result, cover, err := executeTemplate(c.config.TemplateName, tmpl, c.templateData(frontMatter, css, content))
+	if err != nil {
+		return "", "", err
+	}
+	result = setHTMLLang(result, frontMatter.Language)
+	if cover != "" {
+		cover = setHTMLLang(cover, frontMatter.Language)
 	}
 
-	// Replace template placeholders
-	result := template
-	result = strings.ReplaceAll(result, "{{TITLE}}", c.getTitle(frontMatter))
-	result = strings.ReplaceAll(result, "{{CSS}}", css)
-	result = strings.ReplaceAll(result, "{{CONTENT}}", content)
+	if c.math {
+		head, err := mathHead()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to load KaTeX: %w", err)
+		}
+		result = injectHead(result, head)
+	}
 
-	return result, nil
+	return result, cover, nil
 }
 
 func (c *Converter) getTitle(fm *FrontMatter) string {
@@ -251,72 +509,37 @@ func (c *Converter) getTitle(fm *FrontMatter) string {
 	return filepath.Base(c.config.InputPath)
 }
 
-// htmlToPDF converts HTML content to PDF using chromedp
-func (c *Converter) htmlToPDF(htmlContent string) error {
-	return c.htmlToPDFChrome(htmlContent)
-}
-
-// htmlToPDFChrome converts HTML content to PDF using Chrome headless
-func (c *Converter) htmlToPDFChrome(htmlContent string) error {
-	// Create a temporary HTML file
-	tempDir := os.TempDir()
-	tempHTMLPath := filepath.Join(tempDir, fmt.Sprintf("pdfy_%d.html", time.Now().UnixNano()))
-
-	if err := os.WriteFile(tempHTMLPath, []byte(htmlContent), 0o644); err != nil {
-		return fmt.Errorf("failed to write temporary HTML file: %w", err)
-	}
-	defer os.Remove(tempHTMLPath)
-
-	// Create Chrome context
-	ctx, cancel := chromedp.NewContext(context.Background())
-	defer cancel()
-
-	// Set timeout
-	ctx, cancel = context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-
-	var pdfBuffer []byte
-
-	// Navigate to the HTML file and generate PDF
-	err := chromedp.Run(ctx,
-		chromedp.Navigate("file://"+tempHTMLPath),
-		chromedp.WaitReady("body"),
-		chromedp.ActionFunc(func(ctx context.Context) error {
-			// Get PDF with custom options
-			buf, _, err := page.PrintToPDF().
-				WithPrintBackground(true).
-				WithPaperWidth(8.27).  // A4 width in inches
-				WithPaperHeight(11.7). // A4 height in inches
-				WithMarginTop(0.4).
-				WithMarginBottom(0.4).
-				WithMarginLeft(0.4).
-				WithMarginRight(0.4).
-				WithDisplayHeaderFooter(false).
-				Do(ctx)
-			if err != nil {
-				return err
-			}
-
-			pdfBuffer = buf
-			return nil
-		}),
-	)
+// htmlToPDF converts HTML content to PDF using the configured renderer
+func (c *Converter) htmlToPDF(htmlContent string) ([]byte, error) {
+	opts, err := c.printOptions()
 	if err != nil {
-		return fmt.Errorf("failed to generate PDF: %w", err)
+		return nil, fmt.Errorf("invalid page settings: %w", err)
 	}
+	return c.render(htmlContent, opts)
+}
 
-	// Write PDF to output file
-	if err := os.WriteFile(c.config.OutputPath, pdfBuffer, 0o644); err != nil {
-		return fmt.Errorf("failed to write PDF file: %w", err)
+// render converts HTML content to PDF with the given print options
+func (c *Converter) render(htmlContent string, opts *PrintOptions) ([]byte, error) {
+	renderer := c.config.Renderer
+	if renderer == nil {
+		var err error
+		renderer, err = NewRenderer(c.config)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Update stats
-	c.stats.OutputSize = int64(len(pdfBuffer))
-
-	return nil
+	baseDir := filepath.Dir(c.config.InputPath)
+	return renderer.Render(htmlContent, baseDir, opts)
 }
 
 // GetStats returns conversion statistics
 func (c *Converter) GetStats() *ConversionStats {
 	return c.stats
 }
+
+// GetWarnings returns the problems found during conversion that didn't stop
+// it, such as accessibility issues
+func (c *Converter) GetWarnings() []*ConversionError {
+	return c.warnings
+}
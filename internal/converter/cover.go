@@ -0,0 +1,164 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// coverTemplate is the template that renders cover pages. Templates without
+// one use the default template's.
+const coverTemplate = "cover"
+
+// Cover is what the cover page of a document shows
+type Cover struct {
+	Title        string
+	Subtitle     string
+	Author       string
+	Date         string
+	Version      string
+	Organization string
+	// Logo and Image are the URLs of the logo and of the image filling the
+	// page
+	Logo  template.URL
+	Image template.URL
+}
+
+// cover returns the cover page of a document, or nil when it has none
+func (c *Converter) cover(fm *FrontMatter) *Cover {
+	if !c.config.Cover {
+		return nil
+	}
+
+	return &Cover{
+		Title:        c.getTitle(fm),
+		Subtitle:     fm.Subtitle,
+		Author:       fm.Author,
+		Date:         getDate(fm),
+		Version:      fm.Version,
+		Organization: fm.Organization,
+		Logo:         c.coverImageURL("logo", fm.Logo),
+		Image:        c.coverImageURL("cover image", fm.CoverImage),
+	}
+}
+
+// coverImageURL returns the URL of an image named in the front matter,
+// relative to the Markdown file
+func (c *Converter) coverImageURL(what, destination string) template.URL {
+	if destination == "" {
+		return ""
+	}
+	if u, err := url.Parse(destination); err == nil && u.Scheme != "" && !filepath.IsAbs(destination) {
+		return template.URL(destination)
+	}
+
+	path, err := filepath.Abs(resolveInclude(filepath.Dir(c.config.InputPath), destination))
+	if err != nil {
+		return ""
+	}
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		c.warn(lineOrigin{file: c.config.InputPath}, fmt.Sprintf("%s not found: %s", what, destination), "")
+		return ""
+	}
+
+	if c.embedImages() {
+		data, err := imageDataURL(path)
+		if err == nil {
+			return template.URL(data)
+		}
+		c.warn(lineOrigin{file: c.config.InputPath}, fmt.Sprintf("%s not embedded: %v", what, err), "")
+	}
+	return template.URL(absoluteURL(path, &url.URL{}))
+}
+
+// coverPDF renders the cover page to the edges of the paper, without the
+// header and footer. With OddPageChapters, a blank page follows it, so that
+// chapters still start on right-hand pages.
+func (c *Converter) coverPDF(coverHTML string) ([]byte, error) {
+	opts, err := c.printOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid page settings: %w", err)
+	}
+	opts.MarginTop, opts.MarginRight, opts.MarginBottom, opts.MarginLeft = 0, 0, 0, 0
+	opts.PageRanges = ""
+	opts.HeaderTemplate, opts.FooterTemplate = "", ""
+
+	if c.config.OddPageChapters {
+		coverHTML = strings.Replace(coverHTML, "</body>", blankPage+"</body>", 1)
+	}
+	return c.render(coverHTML, opts)
+}
+
+// addCover puts the pages of the cover before those of the document. The
+// document's pages are labelled from 1, so that PDF viewers number them as
+// the footer does.
+func addCover(pdf, cover []byte) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+	conf.CreateBookmarks = false
+
+	ctx, err := api.ReadValidateAndOptimize(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+	coverCtx, err := api.ReadValidateAndOptimize(bytes.NewReader(cover), conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cover: %w", err)
+	}
+	coverPages := coverCtx.PageCount
+
+	// The cover's structure tree isn't merged, so its pages can't refer to
+	// it
+	for i := 1; i <= coverPages; i++ {
+		d, _, _, err := coverCtx.PageDict(i, false)
+		if err != nil {
+			return nil, err
+		}
+		d.Delete("StructParents")
+	}
+
+	// Merging appends the cover's page tree to the document's, so the two
+	// are swapped
+	if err := pdfcpu.MergeXRefTables("", coverCtx, ctx, false, false); err != nil {
+		return nil, err
+	}
+	ref, err := ctx.Pages()
+	if err != nil {
+		return nil, err
+	}
+	pages, err := ctx.DereferenceDict(*ref)
+	if err != nil {
+		return nil, err
+	}
+	kids := pages.ArrayEntry("Kids")
+	if len(kids) != 2 {
+		return nil, fmt.Errorf("unexpected page tree after adding the cover")
+	}
+	pages["Kids"] = types.Array{kids[1], kids[0]}
+
+	catalog, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+	catalog["PageLabels"] = types.Dict{
+		"Nums": types.Array{
+			types.Integer(0), types.Dict{"P": types.StringLiteral("Cover")},
+			types.Integer(coverPages), types.Dict{"S": types.Name("D")},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := api.Write(ctx, &buf, conf); err != nil {
+		return nil, fmt.Errorf("failed to write PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
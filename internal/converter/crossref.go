@@ -0,0 +1,370 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// labelPattern matches labels such as fig:arch, tbl:results or eq:energy
+const labelPattern = `((?:fig|tbl|eq):[\w-]+(?:[:.][\w-]+)*)`
+
+var (
+	// figureLabelRegex matches the label after an image: ![caption](img.png){#fig:arch}
+	figureLabelRegex = regexp.MustCompile(`^\s*\{#` + labelPattern + `\}\s*$`)
+	// tableCaptionRegex matches a table caption: Table: Results {#tbl:results}
+	tableCaptionRegex = regexp.MustCompile(`^Table:\s*(.*?)\s*(?:\{#` + labelPattern + `\})?\s*$`)
+	// mathLabelRegex matches the label after display math: $$ {#eq:energy}
+	mathLabelRegex = regexp.MustCompile(`\s*\{#` + labelPattern + `\}$`)
+	// crossRefRegex matches a reference: @fig:arch
+	crossRefRegex = regexp.MustCompile(`^@` + labelPattern)
+)
+
+// Markers replaced by lists of the figures and tables of the document
+const (
+	listOfFiguresMarker = "<!-- LOF -->"
+	listOfTablesMarker  = "<!-- LOT -->"
+)
+
+// labelKinds maps label prefixes to the names used in references
+var labelKinds = map[string]string{
+	"fig": "Figure",
+	"tbl": "Table",
+	"eq":  "Equation",
+}
+
+// label is a numbered figure, table or equation
+type label struct {
+	id string
+	// prefix is fig, tbl or eq
+	prefix  string
+	number  int
+	caption string
+}
+
+// text returns how references to the label read, such as "Figure 3"
+func (l *label) text() string {
+	return fmt.Sprintf("%s %d", labelKinds[l.prefix], l.number)
+}
+
+var (
+	kindCaptioned = ast.NewNodeKind("Captioned")
+	kindCrossRef  = ast.NewNodeKind("CrossRef")
+	kindLabelList = ast.NewNodeKind("LabelList")
+)
+
+// captioned is a numbered figure or table with its caption
+type captioned struct {
+	ast.BaseBlock
+	label *label
+}
+
+// Kind implements ast.Node
+func (n *captioned) Kind() ast.NodeKind {
+	return kindCaptioned
+}
+
+// Dump implements ast.Node
+func (n *captioned) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": n.label.id}, nil)
+}
+
+// crossRef is a reference to a label, such as @fig:arch
+type crossRef struct {
+	ast.BaseInline
+	id string
+	// target is nil when no figure, table or equation has the label
+	target *label
+}
+
+// Kind implements ast.Node
+func (n *crossRef) Kind() ast.NodeKind {
+	return kindCrossRef
+}
+
+// Dump implements ast.Node
+func (n *crossRef) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": n.id}, nil)
+}
+
+// labelList is a list of the figures or tables of the document
+type labelList struct {
+	ast.BaseBlock
+	title  string
+	labels []*label
+}
+
+// Kind implements ast.Node
+func (n *labelList) Kind() ast.NodeKind {
+	return kindLabelList
+}
+
+// Dump implements ast.Node
+func (n *labelList) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Title": n.title}, nil)
+}
+
+// crossRefExtension parses references to figures, tables and equations,
+// and renders the numbered elements
+type crossRefExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *crossRefExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(util.Prioritized(e, 500)),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// Trigger implements parser.InlineParser
+func (e *crossRefExtension) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// Parse implements parser.InlineParser
+func (e *crossRefExtension) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	// Leave email addresses alone
+	if r := block.PrecendingCharacter(); unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return nil
+	}
+
+	line, _ := block.PeekLine()
+	match := crossRefRegex.FindSubmatch(line)
+	if match == nil {
+		return nil
+	}
+
+	block.Advance(len(match[0]))
+	return &crossRef{id: string(match[1])}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *crossRefExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindCaptioned, e.renderCaptioned)
+	reg.Register(kindCrossRef, e.renderCrossRef)
+	reg.Register(kindLabelList, e.renderLabelList)
+}
+
+func (e *crossRefExtension) renderCaptioned(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	l := n.(*captioned).label
+	caption := fmt.Sprintf("<figcaption>%s: %s</figcaption>\n", l.text(), html.EscapeString(l.caption))
+
+	// Tables are captioned above, figures below
+	if l.prefix == "tbl" {
+		if entering {
+			fmt.Fprintf(w, "<figure class=\"table-figure\" id=\"%s\">\n%s", html.EscapeString(l.id), caption)
+		} else {
+			w.WriteString("</figure>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		fmt.Fprintf(w, "<figure class=\"figure\" id=\"%s\">\n", html.EscapeString(l.id))
+	} else {
+		w.WriteString("\n" + caption + "</figure>\n")
+	}
+	return ast.WalkContinue, nil
+}
+
+func (e *crossRefExtension) renderCrossRef(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*crossRef)
+	if node.target == nil {
+		fmt.Fprintf(w, "<span class=\"ref-missing\" title=\"%s\">??</span>", html.EscapeString(node.id))
+	} else {
+		fmt.Fprintf(w, "<a class=\"ref\" href=\"#%s\">%s</a>", html.EscapeString(node.id), node.target.text())
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (e *crossRefExtension) renderLabelList(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*labelList)
+	fmt.Fprintf(w, "<div class=\"toc\">\n<h2>%s</h2>\n<ul>\n", node.title)
+	for _, l := range node.labels {
+		fmt.Fprintf(w, "<li><a href=\"#%s\">%s: %s</a></li>\n", html.EscapeString(l.id), l.text(), html.EscapeString(l.caption))
+	}
+	w.WriteString("</ul>\n</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// numberLabels numbers the labeled figures and equations and the captioned
+// tables of a document, continuing from earlier documents of a book
+func (c *Converter) numberLabels(doc ast.Node, source []byte) {
+	if c.labels == nil {
+		c.labels = map[string]*label{}
+	}
+
+	add := func(n ast.Node, id, prefix, caption string) *label {
+		l := &label{id: id, prefix: prefix, caption: caption}
+		switch prefix {
+		case "fig":
+			c.figures = append(c.figures, l)
+			l.number = len(c.figures)
+		case "tbl":
+			c.tables = append(c.tables, l)
+			l.number = len(c.tables)
+		case "eq":
+			c.equations++
+			l.number = c.equations
+		}
+
+		// Captioned tables don't need a label
+		if id == "" {
+			l.id = fmt.Sprintf("%s:%d", prefix, l.number)
+		}
+		// References go to the first use of a label
+		if _, ok := c.labels[l.id]; ok {
+			at, snippet := c.sourceLine(n, []byte(id), source)
+			c.warn(at, fmt.Sprintf("duplicate label %q", id), snippet)
+		} else {
+			c.labels[l.id] = l
+		}
+		return l
+	}
+
+	// Collect first, as the tree is changed below
+	var nodes []ast.Node
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch n.(type) {
+		case *ast.Paragraph, *extast.Table, *mathBlock:
+			if entering {
+				nodes = append(nodes, n)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *ast.Paragraph:
+			img, id := labeledImage(n, source)
+			if img == nil {
+				break
+			}
+			l := add(n, id, "fig", string(img.Text(source)))
+			// The figure keeps the paragraph's lines, so that warnings
+			// about the image still point at its source line
+			fig := &captioned{label: l}
+			fig.SetLines(n.Lines())
+			fig.AppendChild(fig, img)
+			n.Parent().ReplaceChild(n.Parent(), n, fig)
+
+		case *extast.Table:
+			para, caption, id := tableCaption(n, source)
+			if para == nil {
+				break
+			}
+			l := add(para, id, "tbl", caption)
+			para.Parent().RemoveChild(para.Parent(), para)
+			fig := &captioned{label: l}
+			n.Parent().ReplaceChild(n.Parent(), n, fig)
+			fig.AppendChild(fig, n)
+
+		case *mathBlock:
+			if n.id != "" {
+				n.number = add(n, n.id, "eq", "").number
+			}
+		}
+	}
+}
+
+// labeledImage returns the image of a paragraph that holds only an image
+// followed by a figure label, and the label
+func labeledImage(para *ast.Paragraph, source []byte) (*ast.Image, string) {
+	img, ok := para.FirstChild().(*ast.Image)
+	if !ok {
+		return nil, ""
+	}
+
+	var rest bytes.Buffer
+	for n := img.NextSibling(); n != nil; n = n.NextSibling() {
+		t, ok := n.(*ast.Text)
+		if !ok {
+			return nil, ""
+		}
+		rest.Write(t.Segment.Value(source))
+	}
+
+	match := figureLabelRegex.FindSubmatch(rest.Bytes())
+	if match == nil || !strings.HasPrefix(string(match[1]), "fig:") {
+		return nil, ""
+	}
+	return img, string(match[1])
+}
+
+// tableCaption finds the caption paragraph right after or before a table,
+// and returns it with the caption and the table's label
+func tableCaption(table *extast.Table, source []byte) (*ast.Paragraph, string, string) {
+	for _, sibling := range []ast.Node{table.NextSibling(), table.PreviousSibling()} {
+		para, ok := sibling.(*ast.Paragraph)
+		if !ok {
+			continue
+		}
+		match := tableCaptionRegex.FindSubmatch(para.Text(source))
+		if match == nil {
+			continue
+		}
+		if id := string(match[2]); id == "" || strings.HasPrefix(id, "tbl:") {
+			return para, string(match[1]), id
+		}
+	}
+	return nil, "", ""
+}
+
+// resolveReferences links references to the numbered elements they name,
+// and fills in the lists of figures and tables. Unknown labels are
+// reported as warnings.
+func (c *Converter) resolveReferences(doc ast.Node, source []byte) {
+	var lists []ast.Node
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *crossRef:
+			n.target = c.labels[n.id]
+			if n.target == nil {
+				at, snippet := c.sourceLine(n, []byte("@"+n.id), source)
+				c.warn(at, fmt.Sprintf("unknown reference @%s", n.id), snippet)
+			}
+		case *ast.HTMLBlock:
+			lists = append(lists, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range lists {
+		list := &labelList{}
+		switch strings.TrimSpace(codeBlockText(n, source)) {
+		case listOfFiguresMarker:
+			list.title, list.labels = "List of Figures", c.figures
+		case listOfTablesMarker:
+			list.title, list.labels = "List of Tables", c.tables
+		default:
+			continue
+		}
+		n.Parent().ReplaceChild(n.Parent(), n, list)
+	}
+}
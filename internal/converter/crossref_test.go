@@ -0,0 +1,25 @@
+package converter
+
+import "testing"
+
+func TestLabeledFigureWarningLine(t *testing.T) {
+	c := New(&Config{InputPath: "doc.md", Tagged: true})
+	content := "# Title\n\nSome text.\n\n![](diagram.png){#fig:diagram}\n"
+	if _, err := c.markdownToHTML([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+	for _, w := range c.GetWarnings() {
+		if w.Message != "image has no alt text" {
+			continue
+		}
+		found = true
+		if w.LineNumber != 5 {
+			t.Errorf("warning on line %d, want 5", w.LineNumber)
+		}
+	}
+	if !found {
+		t.Errorf("no warning for the image without alt text in %v", c.GetWarnings())
+	}
+}
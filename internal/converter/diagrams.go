@@ -0,0 +1,305 @@
+package converter
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/runtime"
+	"github.com/chromedp/chromedp"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// Mermaid is embedded so that diagrams render without network access
+//
+//go:embed mermaid/*
+var mermaidFS embed.FS
+
+// Languages of the fenced code blocks that hold diagrams
+const (
+	mermaidLanguage = "mermaid"
+	dotLanguage     = "dot"
+)
+
+// mermaidScript renders the diagrams passed to it one at a time, as
+// Mermaid doesn't support concurrent rendering
+const mermaidScript = `(async (diagrams) => {
+    mermaid.initialize({startOnLoad: false, securityLevel: "strict"});
+    const results = [];
+    for (const d of diagrams) {
+        try {
+            const {svg} = await mermaid.render(d.id, d.source);
+            results.push({svg: svg});
+        } catch (e) {
+            results.push({error: String(e.message || e)});
+        }
+    }
+    return results;
+})(%s)`
+
+// maxCachedDiagrams is the number of rendered diagrams kept in memory
+const maxCachedDiagrams = 256
+
+// diagramCache holds rendered diagrams by content hash, so that watch mode
+// and batch conversions don't render unchanged diagrams again. The least
+// recently used diagrams are dropped beyond maxCachedDiagrams.
+var diagramCache = struct {
+	sync.Mutex
+	// recent holds cachedSVGs, the most recently used first
+	recent *list.List
+	svgs   map[string]*list.Element
+}{recent: list.New(), svgs: map[string]*list.Element{}}
+
+// cachedSVG is an entry of the diagram cache
+type cachedSVG struct {
+	key string
+	svg string
+}
+
+// kindDiagram is the node kind of rendered diagrams
+var kindDiagram = ast.NewNodeKind("Diagram")
+
+// diagram is a rendered diagram that replaces the code block describing it
+type diagram struct {
+	ast.BaseBlock
+	language string
+	svg      string
+}
+
+// Kind implements ast.Node
+func (n *diagram) Kind() ast.NodeKind {
+	return kindDiagram
+}
+
+// Dump implements ast.Node
+func (n *diagram) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Language": n.language}, nil)
+}
+
+// diagramExtension renders the diagrams inserted by renderDiagrams
+type diagramExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *diagramExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *diagramExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindDiagram, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			node := n.(*diagram)
+			fmt.Fprintf(w, "<div class=\"diagram diagram-%s\">%s</div>\n", node.language, node.svg)
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// mermaidResult is the outcome of rendering one Mermaid diagram
+type mermaidResult struct {
+	SVG   string `json:"svg"`
+	Error string `json:"error"`
+}
+
+// renderDiagrams replaces fenced code blocks in the mermaid and dot
+// languages with the diagrams they describe, as SVG. Mermaid diagrams are
+// rendered in Chrome and Graphviz diagrams with the dot command. Diagrams
+// that fail to render are reported as warnings and stay code blocks.
+func (c *Converter) renderDiagrams(doc ast.Node, source []byte) {
+	var blocks []*ast.FencedCodeBlock
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if block, ok := n.(*ast.FencedCodeBlock); ok && entering {
+			switch string(block.Language(source)) {
+			case mermaidLanguage, dotLanguage:
+				blocks = append(blocks, block)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	var mermaidBlocks []*ast.FencedCodeBlock
+	var mermaidSources []string
+	for _, block := range blocks {
+		language := string(block.Language(source))
+		code := codeBlockText(block, source)
+		key := diagramKey(language, code)
+
+		if svg, ok := cachedDiagram(key); ok {
+			replaceWithDiagram(block, language, svg)
+			continue
+		}
+
+		if language == mermaidLanguage {
+			mermaidBlocks = append(mermaidBlocks, block)
+			mermaidSources = append(mermaidSources, code)
+			continue
+		}
+
+		svg, err := renderDot(code)
+		if err != nil {
+			c.warnDiagram(block, source, err)
+			continue
+		}
+		cacheDiagram(key, svg)
+		replaceWithDiagram(block, language, svg)
+	}
+
+	if len(mermaidBlocks) == 0 {
+		return
+	}
+
+	results, err := c.renderMermaid(mermaidSources)
+	for i, block := range mermaidBlocks {
+		switch {
+		case err != nil:
+			c.warnDiagram(block, source, err)
+		case results[i].Error != "":
+			c.warnDiagram(block, source, errors.New(results[i].Error))
+		default:
+			cacheDiagram(diagramKey(mermaidLanguage, mermaidSources[i]), results[i].SVG)
+			replaceWithDiagram(block, mermaidLanguage, results[i].SVG)
+		}
+	}
+}
+
+// renderMermaid renders Mermaid diagrams to SVG in Chrome
+func (c *Converter) renderMermaid(sources []string) ([]mermaidResult, error) {
+	script, err := mermaidFS.ReadFile("mermaid/mermaid.min.js")
+	if err != nil {
+		return nil, err
+	}
+
+	type mermaidInput struct {
+		ID     string `json:"id"`
+		Source string `json:"source"`
+	}
+	diagrams := make([]mermaidInput, len(sources))
+	for i, source := range sources {
+		// IDs derived from the content keep cached diagrams from clashing
+		diagrams[i] = mermaidInput{ID: "mermaid-" + diagramKey(mermaidLanguage, source)[:16], Source: source}
+	}
+	input, err := json.Marshal(diagrams)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := c.config.Browser
+	if pool == nil {
+		pool = NewBrowserPool(1, c.config.Chrome)
+		defer pool.Close()
+	}
+
+	page := "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"UTF-8\">\n<script>\n" + string(script) + "</script>\n</head>\n<body></body>\n</html>\n"
+
+	var results []mermaidResult
+	err = pool.Run(30*time.Second,
+		setDocumentContent(page),
+		chromedp.Evaluate(fmt.Sprintf(mermaidScript, input), &results, func(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+			return p.WithAwaitPromise(true)
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render Mermaid diagrams: %w", err)
+	}
+	if len(results) != len(sources) {
+		return nil, fmt.Errorf("failed to render Mermaid diagrams: got %d results for %d diagrams", len(results), len(sources))
+	}
+
+	return results, nil
+}
+
+// renderDot renders a Graphviz diagram to SVG with the dot command
+func renderDot(source string) (string, error) {
+	path, err := exec.LookPath("dot")
+	if err != nil {
+		return "", errors.New("Graphviz dot command not found, install Graphviz to render dot diagrams")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(path, "-Tsvg")
+	cmd.Stdin = strings.NewReader(source)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("dot failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	// Drop the XML declaration and doctype, which don't belong in HTML
+	svg := stdout.String()
+	if i := strings.Index(svg, "<svg"); i >= 0 {
+		svg = svg[i:]
+	}
+	return svg, nil
+}
+
+// warnDiagram reports a diagram that couldn't be rendered
+func (c *Converter) warnDiagram(block *ast.FencedCodeBlock, source []byte, err error) {
+	at, snippet := c.sourceLine(block, nil, source)
+	c.warn(at, fmt.Sprintf("%s diagram not rendered: %v", block.Language(source), err), snippet)
+}
+
+// replaceWithDiagram puts a rendered diagram in place of its code block
+func replaceWithDiagram(block *ast.FencedCodeBlock, language, svg string) {
+	parent := block.Parent()
+	parent.ReplaceChild(parent, block, &diagram{language: language, svg: svg})
+}
+
+// codeBlockText returns the content of a code block
+func codeBlockText(block ast.Node, source []byte) string {
+	var b strings.Builder
+	lines := block.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		b.Write(segment.Value(source))
+	}
+	return b.String()
+}
+
+// diagramKey identifies a diagram by its language and content
+func diagramKey(language, code string) string {
+	sum := sha256.Sum256([]byte(language + "\x00" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func cachedDiagram(key string) (string, bool) {
+	diagramCache.Lock()
+	defer diagramCache.Unlock()
+	e, ok := diagramCache.svgs[key]
+	if !ok {
+		return "", false
+	}
+	diagramCache.recent.MoveToFront(e)
+	return e.Value.(*cachedSVG).svg, true
+}
+
+func cacheDiagram(key, svg string) {
+	diagramCache.Lock()
+	defer diagramCache.Unlock()
+	if e, ok := diagramCache.svgs[key]; ok {
+		e.Value.(*cachedSVG).svg = svg
+		diagramCache.recent.MoveToFront(e)
+		return
+	}
+
+	diagramCache.svgs[key] = diagramCache.recent.PushFront(&cachedSVG{key: key, svg: svg})
+	for diagramCache.recent.Len() > maxCachedDiagrams {
+		e := diagramCache.recent.Back()
+		diagramCache.recent.Remove(e)
+		delete(diagramCache.svgs, e.Value.(*cachedSVG).key)
+	}
+}
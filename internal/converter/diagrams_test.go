@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDiagramCacheBound(t *testing.T) {
+	key := func(i int) string { return diagramKey("mermaid", fmt.Sprintf("graph TD; A%d", i)) }
+
+	cacheDiagram(key(0), "<svg>0</svg>")
+	for i := 1; i <= maxCachedDiagrams; i++ {
+		// Keep the first diagram in use
+		if _, ok := cachedDiagram(key(0)); !ok {
+			t.Fatalf("diagram 0 dropped after %d others", i-1)
+		}
+		cacheDiagram(key(i), fmt.Sprintf("<svg>%d</svg>", i))
+	}
+
+	if n := len(diagramCache.svgs); n > maxCachedDiagrams {
+		t.Errorf("cache holds %d diagrams, want at most %d", n, maxCachedDiagrams)
+	}
+	if svg, ok := cachedDiagram(key(0)); !ok || svg != "<svg>0</svg>" {
+		t.Errorf("recently used diagram = %q, %v", svg, ok)
+	}
+	if _, ok := cachedDiagram(key(1)); ok {
+		t.Error("least recently used diagram still cached")
+	}
+}
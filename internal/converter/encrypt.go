@@ -0,0 +1,106 @@
+package converter
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+)
+
+// EncryptionOptions protects a PDF with AES-256 encryption
+type EncryptionOptions struct {
+	// UserPassword is needed to open the document. When empty, anyone can
+	// open it but the permissions still apply.
+	UserPassword string
+	// OwnerPassword unlocks everything the permissions restrict. A random
+	// one is used when empty.
+	OwnerPassword string
+	// Permissions lists what users without the owner password may do, see
+	// PermissionNames. Nothing is allowed by default.
+	Permissions []string
+}
+
+// permissions maps permission names to the PDF permission bits they grant
+var permissions = map[string]model.PermissionFlags{
+	"print":    model.PermissionPrintRev2 | model.PermissionPrintRev3,
+	"modify":   model.PermissionModify,
+	"copy":     model.PermissionExtract | model.PermissionExtractRev3,
+	"annotate": model.PermissionModAnnFillForm,
+	"fill":     model.PermissionFillRev3,
+	"assemble": model.PermissionAssembleRev3,
+}
+
+// PermissionNames returns the names accepted in EncryptionOptions.Permissions
+func PermissionNames() []string {
+	names := []string{"all", "none"}
+	for name := range permissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// permissionFlags converts permission names to PDF permission bits
+func permissionFlags(names []string) (model.PermissionFlags, error) {
+	flags := model.PermissionsNone
+
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "", "none":
+			continue
+		case "all":
+			return model.PermissionsAll, nil
+		}
+
+		flag, ok := permissions[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown permission %q (available: %s)", name, strings.Join(PermissionNames(), ", "))
+		}
+		flags |= flag
+	}
+
+	return flags, nil
+}
+
+// encrypt encrypts a PDF with AES-256
+func encrypt(pdf []byte, opts *EncryptionOptions) ([]byte, error) {
+	flags, err := permissionFlags(opts.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without an owner password, the user password would unlock everything
+	ownerPassword := opts.OwnerPassword
+	if ownerPassword == "" {
+		if ownerPassword, err = randomPassword(); err != nil {
+			return nil, err
+		}
+	}
+
+	conf := model.NewAESConfiguration(opts.UserPassword, ownerPassword, 256)
+	conf.ValidationMode = model.ValidationRelaxed
+	conf.Permissions = flags
+
+	var buf bytes.Buffer
+	if err := api.Encrypt(bytes.NewReader(pdf), &buf, conf); err != nil {
+		return nil, err
+	}
+
+	// Encrypting rewrites the document, so the producer is set afterwards
+	return setProducer(buf.Bytes(), opts.UserPassword, ownerPassword)
+}
+
+// randomPassword returns a password nobody knows
+func randomPassword() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,103 @@
+package converter
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Running headers and footers are defined in templates with
+//
+//	<template id="pdfy-header">...</template>
+//	<template id="pdfy-footer">...</template>
+//
+// and may use the tokens {{page}}, {{pages}}, {{title}}, {{date}} and
+// {{author}}. They are printed in the page margins on every page.
+var headerFooterRegex = regexp.MustCompile(`(?s)<template\s+id="pdfy-(header|footer)"\s*>(.*?)</template>[ \t]*\n?`)
+
+var headerFooterTokenRegex = regexp.MustCompile(`\{\{\s*(page|pages|title|date|author)\s*\}\}`)
+
+// noHeaderFooter disables a header or footer defined by the template
+const noHeaderFooter = "none"
+
+// headerFooterStyle is applied to headers and footers, as Chrome renders
+// them with a tiny default font and no padding
+const headerFooterStyle = "width: 100%%; padding: 0 %gin 0 %gin; box-sizing: border-box; " +
+	"font-size: 9px; color: #666; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', 'Roboto', sans-serif;"
+
+// extractHeaderFooter removes the header and footer definitions from a
+// template, returning the template and the definitions
+func extractHeaderFooter(tmpl string) (string, string, string) {
+	var header, footer string
+
+	for _, match := range headerFooterRegex.FindAllStringSubmatch(tmpl, -1) {
+		if match[1] == "header" {
+			header = strings.TrimSpace(match[2])
+		} else {
+			footer = strings.TrimSpace(match[2])
+		}
+	}
+
+	return headerFooterRegex.ReplaceAllString(tmpl, ""), header, footer
+}
+
+// setHeaderFooter selects the header and footer for the document. Headers
+// and footers from the configuration take precedence over the template's.
+func (c *Converter) setHeaderFooter(templateHeader, templateFooter string, fm *FrontMatter) {
+	header := c.config.Header
+	if header == "" {
+		header = templateHeader
+	}
+	footer := c.config.Footer
+	if footer == "" {
+		footer = templateFooter
+	}
+
+	c.header = c.headerFooterHTML(header, fm)
+	c.footer = c.headerFooterHTML(footer, fm)
+}
+
+// headerFooterHTML expands the tokens in a header or footer definition
+// into Chrome's print template markup
+func (c *Converter) headerFooterHTML(definition string, fm *FrontMatter) string {
+	if definition == "" || definition == noHeaderFooter {
+		return ""
+	}
+
+	values := map[string]string{
+		"page":   `<span class="pageNumber"></span>`,
+		"pages":  `<span class="totalPages"></span>`,
+		"title":  html.EscapeString(c.getTitle(fm)),
+		"date":   html.EscapeString(getDate(fm)),
+		"author": html.EscapeString(fm.Author),
+	}
+
+	content := headerFooterTokenRegex.ReplaceAllStringFunc(definition, func(token string) string {
+		name := headerFooterTokenRegex.FindStringSubmatch(token)[1]
+		return values[name]
+	})
+
+	return content
+}
+
+// headerFooterTemplate wraps a header or footer so that it lines up with
+// the page margins
+func headerFooterTemplate(content string, opts *PrintOptions) string {
+	if content == "" {
+		// Chrome prints its own header or footer when the template is empty
+		return "<span></span>"
+	}
+
+	style := fmt.Sprintf(headerFooterStyle, opts.MarginLeft, opts.MarginRight)
+	return `<div style="` + style + `">` + content + `</div>`
+}
+
+// getDate returns the document date from the front matter, or today's date
+func getDate(fm *FrontMatter) string {
+	if fm.Date != "" {
+		return fm.Date
+	}
+	return time.Now().Format("January 2, 2006")
+}
@@ -0,0 +1,369 @@
+package converter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Include directives splice another Markdown file into a document. They
+// must be on a line of their own:
+//
+//	<!-- include: chapters/intro.md -->
+//	{{< include "chapters/intro.md" >}}
+var includeRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^\s*<!--\s*include:\s*(.+?)\s*-->\s*$`),
+	regexp.MustCompile(`^\s*\{\{<\s*include\s+"([^"]+)"\s*>\}\}\s*$`),
+}
+
+var fenceRegex = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})(.*)$")
+
+// Region markers are comments that start a line of a source file, such as
+// "// #region setup" and "// #endregion", "#region setup" where # starts
+// comments, or mdBook's "// ANCHOR: setup" and "// ANCHOR_END: setup"
+var (
+	regionStartRegex = regexp.MustCompile(`^\s*(?:(?:` + commentPattern + `)\s*#region|#region|(?:` + commentPattern + `|#)\s*ANCHOR:)\s+([^\s*-]\S*?)(?:\s|\*/|-->|$)`)
+	regionEndRegex   = regexp.MustCompile(`^\s*(?:(?:` + commentPattern + `)\s*#endregion|#endregion|(?:` + commentPattern + `|#)\s*ANCHOR_END:)(?:\s+([^\s*-]\S*?))?(?:\s|\*/|-->|$)`)
+)
+
+// commentPattern matches the tokens that start comments before region
+// markers, besides #
+const commentPattern = `//|--|/\*|<!--`
+
+// lineOrigin is the file and line number a line of Markdown content was
+// read from
+type lineOrigin struct {
+	file string
+	line int
+}
+
+// expandIncludes replaces the include directives in Markdown content read
+// from path with the files they name, and fills code blocks that have a
+// file attribute with the source file:
+//
+//	```go file=main.go lines=10-30
+//	```
+//
+//	```go file=main.go region=setup
+//	```
+//
+// Paths are relative to the including file. firstLine is the line number of
+// the content's first line in its file. The origin of every line of the
+// result is returned with it.
+func expandIncludes(path string, content []byte, firstLine int, stack []string) ([]byte, []lineOrigin, error) {
+	stack = append(stack[:len(stack):len(stack)], path)
+	dir := filepath.Dir(path)
+
+	var out bytes.Buffer
+	var origins []lineOrigin
+	emit := func(line string, at lineOrigin) {
+		out.WriteString(line)
+		origins = append(origins, at)
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	// The fence of the code block being copied, if any
+	var fence string
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		text := strings.TrimRight(line, "\r\n")
+		at := lineOrigin{file: path, line: firstLine + i}
+
+		if fence != "" {
+			if isClosingFence(text, fence) {
+				fence = ""
+			}
+			emit(line, at)
+			continue
+		}
+
+		if match := fenceRegex.FindStringSubmatch(text); match != nil {
+			language, attrs := parseFenceInfo(match[2])
+			if attrs["file"] == "" {
+				fence = match[1]
+				emit(line, at)
+				continue
+			}
+
+			code, err := includeCode(resolveInclude(dir, attrs["file"]), attrs)
+			if err != nil {
+				return nil, nil, includeError(at, text, err)
+			}
+
+			// Any content of the block is replaced by the file
+			for i+1 < len(lines) && !isClosingFence(strings.TrimRight(lines[i+1], "\r\n"), match[1]) {
+				i++
+			}
+			i++
+
+			codeFence := strings.Repeat("`", max(3, longestFence(code)+1))
+			emit(codeFence+language+"\n", at)
+			for _, codeLine := range code {
+				emit(codeLine+"\n", at)
+			}
+			emit(codeFence+"\n", at)
+			continue
+		}
+
+		target := includeTarget(text)
+		if target == "" {
+			emit(line, at)
+			continue
+		}
+
+		included, includedOrigins, err := includeMarkdown(resolveInclude(dir, target), stack)
+		if err != nil {
+			var convErr *ConversionError
+			if errors.As(err, &convErr) {
+				return nil, nil, err
+			}
+			return nil, nil, includeError(at, text, err)
+		}
+		out.Write(included)
+		origins = append(origins, includedOrigins...)
+	}
+
+	return out.Bytes(), origins, nil
+}
+
+// includeMarkdown reads and expands an included Markdown file. Its front
+// matter is ignored.
+func includeMarkdown(path string, stack []string) ([]byte, []lineOrigin, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, including := range stack {
+		if other, err := filepath.Abs(including); err == nil && other == abs {
+			return nil, nil, fmt.Errorf("include cycle: %s -> %s", strings.Join(stack[i:], " -> "), path)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read included file: %w", err)
+	}
+
+	_, markdownContent, err := (&Converter{}).parseFrontMatter(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse front matter of %s: %w", path, err)
+	}
+	firstLine := bytes.Count(content[:len(content)-len(markdownContent)], []byte("\n")) + 1
+
+	expanded, origins, err := expandIncludes(path, markdownContent, firstLine, stack)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Keep the last line of the file from running into the next one
+	if len(expanded) > 0 && !bytes.HasSuffix(expanded, []byte("\n")) {
+		expanded = append(expanded, '\n')
+	}
+
+	return expanded, origins, nil
+}
+
+// includeCode returns the lines of a source file selected by the lines or
+// region attribute of a code block, with their common indentation removed
+func includeCode(path string, attrs map[string]string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read included file: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	switch {
+	case attrs["lines"] != "" && attrs["region"] != "":
+		return nil, errors.New("use either lines or region, not both")
+
+	case attrs["lines"] != "":
+		start, end, err := parseLineRange(attrs["lines"], len(lines))
+		if err != nil {
+			return nil, err
+		}
+		lines = lines[start-1 : end]
+
+	case attrs["region"] != "":
+		if lines, err = extractRegion(lines, attrs["region"]); err != nil {
+			return nil, err
+		}
+	}
+
+	return dedent(lines), nil
+}
+
+// parseLineRange parses a range of line numbers such as "10-30", "10-" or
+// "5" in a file with n lines
+func parseLineRange(spec string, n int) (int, int, error) {
+	from, to, isRange := strings.Cut(spec, "-")
+
+	start, end := 1, n
+	var err error
+	if from != "" {
+		if start, err = strconv.Atoi(from); err != nil {
+			return 0, 0, fmt.Errorf("invalid line range %q", spec)
+		}
+	}
+	if !isRange {
+		end = start
+	} else if to != "" {
+		if end, err = strconv.Atoi(to); err != nil {
+			return 0, 0, fmt.Errorf("invalid line range %q", spec)
+		}
+	}
+
+	if start < 1 || end < start {
+		return 0, 0, fmt.Errorf("invalid line range %q", spec)
+	}
+	if end > n {
+		return 0, 0, fmt.Errorf("line range %q is outside the file, which has %d lines", spec, n)
+	}
+
+	return start, end, nil
+}
+
+// extractRegion returns the lines between the start and end markers of a
+// named region. Markers of regions nested inside it are left out.
+func extractRegion(lines []string, name string) ([]string, error) {
+	start := -1
+	for i, line := range lines {
+		if match := regionStartRegex.FindStringSubmatch(line); match != nil && match[1] == name {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("region %q not found", name)
+	}
+
+	var region []string
+	depth := 0
+	for _, line := range lines[start:] {
+		if match := regionEndRegex.FindStringSubmatch(line); match != nil {
+			if match[1] == name || (match[1] == "" && depth == 0) {
+				return region, nil
+			}
+			depth--
+			continue
+		}
+		if regionStartRegex.MatchString(line) {
+			depth++
+			continue
+		}
+		region = append(region, line)
+	}
+
+	return nil, fmt.Errorf("region %q is not closed", name)
+}
+
+// dedent removes the indentation that all non-blank lines share
+func dedent(lines []string) []string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent <= 0 {
+		return lines
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= indent {
+			result[i] = line[indent:]
+		}
+	}
+	return result
+}
+
+// parseFenceInfo splits the info string of a code fence into the language
+// and key=value attributes
+func parseFenceInfo(info string) (string, map[string]string) {
+	var language string
+	attrs := map[string]string{}
+
+	for i, field := range strings.Fields(info) {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			if i == 0 {
+				language = field
+			}
+			continue
+		}
+		attrs[key] = strings.Trim(value, `"'`)
+	}
+
+	return language, attrs
+}
+
+// isClosingFence reports whether a line closes a code block opened with
+// fence
+func isClosingFence(line, fence string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || !strings.HasPrefix(trimmed, fence) {
+		return false
+	}
+	return strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1])) == ""
+}
+
+// longestFence returns the length of the longest run of backticks that
+// starts a line
+func longestFence(lines []string) int {
+	longest := 0
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		longest = max(longest, len(trimmed)-len(strings.TrimLeft(trimmed, "`")))
+	}
+	return longest
+}
+
+// includeTarget returns the path named by an include directive, or "" if
+// the line isn't one
+func includeTarget(line string) string {
+	for _, re := range includeRegexes {
+		if match := re.FindStringSubmatch(line); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// resolveInclude returns the path of an included file relative to the
+// including file's directory
+func resolveInclude(dir, path string) string {
+	path = filepath.FromSlash(path)
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// includeError reports a failed include at the line of its directive
+func includeError(at lineOrigin, directive string, err error) *ConversionError {
+	return &ConversionError{
+		File:       at.file,
+		LineNumber: at.line,
+		Message:    err.Error(),
+		Snippet:    strings.TrimSpace(directive),
+		Err:        err,
+	}
+}
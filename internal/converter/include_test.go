@@ -0,0 +1,227 @@
+package converter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files with the given contents under dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExpandIncludesNested(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"chapters/one.md":          "---\ntitle: ignored\n---\n# One\n<!-- include: parts/detail.md -->\nEnd of one",
+		"chapters/parts/detail.md": "Detail\n",
+	})
+	main := filepath.Join(dir, "main.md")
+
+	got, origins, err := expandIncludes(main, []byte("# Book\n{{< include \"chapters/one.md\" >}}\nAfter\n"), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "# Book\n# One\nDetail\nEnd of one\nAfter\n"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	one := filepath.Join(dir, "chapters", "one.md")
+	detail := filepath.Join(dir, "chapters", "parts", "detail.md")
+	wantOrigins := []lineOrigin{
+		{file: main, line: 1},
+		{file: one, line: 4},
+		{file: detail, line: 1},
+		{file: one, line: 6},
+		{file: main, line: 3},
+	}
+	if !reflect.DeepEqual(origins, wantOrigins) {
+		t.Errorf("origins = %v, want %v", origins, wantOrigins)
+	}
+}
+
+func TestExpandIncludesCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.md": "# A\n<!-- include: b.md -->\n",
+		"b.md": "# B\n\n<!-- include: a.md -->\n",
+	})
+	a := filepath.Join(dir, "a.md")
+	b := filepath.Join(dir, "b.md")
+
+	_, _, err := expandIncludes(a, []byte("# A\n<!-- include: b.md -->\n"), 1, nil)
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("error = %v, want a ConversionError", err)
+	}
+	if convErr.File != b || convErr.LineNumber != 3 {
+		t.Errorf("error at %s:%d, want %s:3", convErr.File, convErr.LineNumber, b)
+	}
+	if want := "include cycle: " + a + " -> " + b + " -> " + a; !strings.Contains(convErr.Message, want) {
+		t.Errorf("message = %q, want %q", convErr.Message, want)
+	}
+	if convErr.Snippet != "<!-- include: a.md -->" {
+		t.Errorf("snippet = %q", convErr.Snippet)
+	}
+}
+
+func TestExpandIncludesCode(t *testing.T) {
+	const source = `package main
+
+// #region setup
+	x := 1
+	// #region inner
+	y := 2
+	// #endregion inner
+// #endregion setup
+
+// #region config
+region := "us-east-1"
+regions := []string{region} // #region is not a marker here
+// #endregion
+
+// #region open
+z := 3
+`
+
+	tests := []struct {
+		name    string
+		fence   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "lines",
+			fence: "```go file=main.go lines=1",
+			want:  "```go\npackage main\n```\n",
+		},
+		{
+			name:  "region with nested markers",
+			fence: "```go file=main.go region=setup",
+			want:  "```go\nx := 1\ny := 2\n```\n",
+		},
+		{
+			name:  "region with code named region",
+			fence: "```go file=main.go region=config",
+			want:  "```go\nregion := \"us-east-1\"\nregions := []string{region} // #region is not a marker here\n```\n",
+		},
+		{
+			name:    "missing region marker",
+			fence:   "```go file=main.go region=teardown",
+			wantErr: `region "teardown" not found`,
+		},
+		{
+			name:    "unclosed region",
+			fence:   "```go file=main.go region=open",
+			wantErr: `region "open" is not closed`,
+		},
+		{
+			name:    "lines outside the file",
+			fence:   "```go file=main.go lines=5-50",
+			wantErr: `line range "5-50" is outside the file, which has 16 lines`,
+		},
+		{
+			name:    "missing file",
+			fence:   "```go file=missing.go",
+			wantErr: "failed to read included file",
+		},
+	}
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"main.go": source})
+	doc := filepath.Join(dir, "doc.md")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "Intro\n\n" + tt.fence + "\n```\n"
+			got, _, err := expandIncludes(doc, []byte(content), 1, nil)
+
+			if tt.wantErr != "" {
+				var convErr *ConversionError
+				if !errors.As(err, &convErr) {
+					t.Fatalf("error = %v, want a ConversionError", err)
+				}
+				if convErr.File != doc || convErr.LineNumber != 3 || !strings.Contains(convErr.Message, tt.wantErr) {
+					t.Errorf("error = %s:%d: %s, want %s:3: %s", convErr.File, convErr.LineNumber, convErr.Message, doc, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := "Intro\n\n" + tt.want; string(got) != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExtractRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{
+			name:   "unnamed end",
+			source: "// #region a\nregion := 1\n// #endregion\nafter",
+			want:   []string{"region := 1"},
+		},
+		{
+			name:   "hash comments",
+			source: "#region a\nregion = 1\n#endregion a",
+			want:   []string{"region = 1"},
+		},
+		{
+			name:   "block comments",
+			source: "/* #region a */\nx\n/* #endregion */",
+			want:   []string{"x"},
+		},
+		{
+			name:   "HTML comments",
+			source: "<!-- #region a -->\n<p>x</p>\n<!-- #endregion a -->",
+			want:   []string{"<p>x</p>"},
+		},
+		{
+			name:   "SQL comments",
+			source: "-- #region a\nSELECT region FROM t;\n-- #endregion",
+			want:   []string{"SELECT region FROM t;"},
+		},
+		{
+			name:   "mdBook anchors",
+			source: "# ANCHOR: a\nx = 1\n# ANCHOR: b\ny = 2\n# ANCHOR_END: b\n# ANCHOR_END: a",
+			want:   []string{"x = 1", "y = 2"},
+		},
+		{
+			name:   "markers in code",
+			source: "// #region a\nendregion := region\nlog(\"#endregion a\")\n// #endregion a",
+			want:   []string{"endregion := region", `log("#endregion a")`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractRegion(strings.Split(tt.source, "\n"), "a")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
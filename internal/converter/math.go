@@ -0,0 +1,393 @@
+package converter
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KaTeX renders the math in Chrome. The script, styles and fonts are
+// embedded so that no network access is needed.
+//
+//go:embed katex/*
+var katexFS embed.FS
+
+var katexFontRegex = regexp.MustCompile(`url\('(fonts/[^']+\.woff2)'\)`)
+
+// mathScript renders the math elements with KaTeX. Rendering is registered
+// in window.pdfyRendering, which the Chrome renderer waits for before
+// printing.
+const mathScript = `(window.pdfyRendering = window.pdfyRendering || []).push(new Promise(function (resolve) {
+    function render() {
+        document.querySelectorAll(".math").forEach(function (el) {
+            katex.render(el.textContent, el, {
+                displayMode: el.classList.contains("display"),
+                throwOnError: false
+            });
+        });
+        resolve();
+    }
+    if (document.readyState === "loading") {
+        document.addEventListener("DOMContentLoaded", render);
+    } else {
+        render();
+    }
+}));
+`
+
+// kindMath is the node kind of inline math
+var kindMath = ast.NewNodeKind("Math")
+
+// kindMathBlock is the node kind of display math blocks
+var kindMathBlock = ast.NewNodeKind("MathBlock")
+
+// mathInline is TeX math within a paragraph, written as $...$, or as
+// $$...$$ for display style
+type mathInline struct {
+	ast.BaseInline
+	display bool
+	tex     []byte
+}
+
+// Kind implements ast.Node
+func (n *mathInline) Kind() ast.NodeKind {
+	return kindMath
+}
+
+// Dump implements ast.Node
+func (n *mathInline) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Display": fmt.Sprint(n.display),
+		"TeX":     string(n.tex),
+	}, nil)
+}
+
+// mathBlock is display math on lines of its own, between $$ delimiters. A
+// label after the closing delimiter numbers the equation:
+//
+//	$$
+//	E = mc^2
+//	$$ {#eq:energy}
+type mathBlock struct {
+	ast.BaseBlock
+
+	// closed is set once the closing delimiter has been read
+	closed bool
+
+	// id is the equation's label, and number its number once labels are
+	// numbered
+	id     string
+	number int
+}
+
+// Kind implements ast.Node
+func (n *mathBlock) Kind() ast.NodeKind {
+	return kindMathBlock
+}
+
+// IsRaw implements ast.Node
+func (n *mathBlock) IsRaw() bool {
+	return true
+}
+
+// Dump implements ast.Node
+func (n *mathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": n.id}, nil)
+}
+
+// mathExtension adds $...$ inline math and $$...$$ display math to
+// Markdown
+type mathExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(&mathBlockParser{}, 700)),
+		parser.WithInlineParsers(util.Prioritized(&mathParser{}, 500)),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(&mathRenderer{}, 500)),
+	)
+}
+
+// mathParser parses inline math. As in Pandoc, the opening $ must be
+// followed by a non-space character, and the closing $ must follow a
+// non-space character and not be followed by a digit, so that prices such
+// as $5 and $10 stay text.
+type mathParser struct{}
+
+// Trigger implements parser.InlineParser
+func (p *mathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse implements parser.InlineParser
+func (p *mathParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	delim := []byte("$")
+	if bytes.HasPrefix(line, []byte("$$")) {
+		delim = []byte("$$")
+	}
+
+	body := line[len(delim):]
+	if len(body) == 0 || util.IsSpace(body[0]) {
+		return nil
+	}
+
+	for i := 0; i < len(body); i++ {
+		switch {
+		case body[i] == '\\':
+			i++
+			continue
+		case !bytes.HasPrefix(body[i:], delim) || i == 0 || util.IsSpace(body[i-1]):
+			continue
+		}
+
+		end := i + len(delim)
+		if len(delim) == 1 && end < len(body) && body[end] >= '0' && body[end] <= '9' {
+			continue
+		}
+
+		block.Advance(len(delim) + end)
+		return &mathInline{
+			display: len(delim) == 2,
+			tex:     append([]byte{}, body[:i]...),
+		}
+	}
+
+	return nil
+}
+
+// mathBlockParser parses display math blocks:
+//
+//	$$
+//	E = mc^2
+//	$$
+type mathBlockParser struct{}
+
+// Trigger implements parser.BlockParser
+func (p *mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Open implements parser.BlockParser. A block opens at a line of just $$,
+// when a closing $$ follows before a blank line, or at a line with the whole
+// block. Other lines starting with $$ are left to the inline parser.
+func (p *mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], []byte("$$")) {
+		return nil, parser.NoChildren
+	}
+
+	node := &mathBlock{}
+	start := segment.Start + pos + 2
+	rest := util.TrimRightSpace(line[pos+2:])
+
+	switch body, id := cutMathLabel(rest); {
+	case len(rest) == 0:
+		if !mathBlockCloses(reader) {
+			return nil, parser.NoChildren
+		}
+	case len(body) > 2 && bytes.HasSuffix(body, []byte("$$")) && !bytes.Contains(body[:len(body)-2], []byte("$$")):
+		node.Lines().Append(text.NewSegment(start, start+len(body)-2))
+		node.closed = true
+		node.id = id
+	default:
+		return nil, parser.NoChildren
+	}
+
+	return node, parser.NoChildren
+}
+
+// mathBlockCloses reports whether a line closing a display math block
+// follows the current line before a blank line or the end of the input. The
+// reader is left where it was.
+func mathBlockCloses(reader text.Reader) bool {
+	lineNum, pos := reader.Position()
+	defer reader.SetPosition(lineNum, pos)
+
+	reader.AdvanceLine()
+	for {
+		line, _ := reader.PeekLine()
+		if line == nil || util.IsBlank(line) {
+			return false
+		}
+		if trimmed, _ := cutMathLabel(util.TrimRightSpace(line)); bytes.HasSuffix(trimmed, []byte("$$")) {
+			return true
+		}
+		reader.AdvanceLine()
+	}
+}
+
+// Continue implements parser.BlockParser
+func (p *mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	block := node.(*mathBlock)
+	if block.closed {
+		return parser.Close
+	}
+
+	line, segment := reader.PeekLine()
+	trimmed, id := cutMathLabel(util.TrimRightSpace(line))
+	if bytes.HasSuffix(trimmed, []byte("$$")) {
+		if len(util.TrimLeftSpace(trimmed)) > 2 {
+			node.Lines().Append(text.NewSegment(segment.Start, segment.Start+len(trimmed)-2))
+		}
+		block.id = id
+		reader.Advance(lineLength(line, segment))
+		return parser.Close
+	}
+
+	node.Lines().Append(segment)
+	reader.Advance(lineLength(line, segment))
+	return parser.Continue | parser.NoChildren
+}
+
+// cutMathLabel removes an equation label from the end of the line that
+// closes a display math block, and returns it
+func cutMathLabel(line []byte) ([]byte, string) {
+	match := mathLabelRegex.FindSubmatchIndex(line)
+	if match == nil || !bytes.HasPrefix(line[match[2]:], []byte("eq:")) {
+		return line, ""
+	}
+	body := line[:match[0]]
+	if !bytes.HasSuffix(body, []byte("$$")) {
+		return line, ""
+	}
+	return body, string(line[match[2]:match[3]])
+}
+
+// lineLength returns the length of a line without its newline
+func lineLength(line []byte, segment text.Segment) int {
+	if bytes.HasSuffix(line, []byte("\n")) {
+		return segment.Len() - 1
+	}
+	return segment.Len()
+}
+
+// Close implements parser.BlockParser
+func (p *mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph implements parser.BlockParser
+func (p *mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser
+func (p *mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// mathRenderer writes math as escaped TeX in elements that KaTeX renders
+// in the browser
+type mathRenderer struct{}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (r *mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindMath, r.renderMath)
+	reg.Register(kindMathBlock, r.renderMathBlock)
+}
+
+func (r *mathRenderer) renderMath(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*mathInline)
+	class := "math inline"
+	if node.display {
+		class = "math display"
+	}
+
+	fmt.Fprintf(w, `<span class="%s">`, class)
+	w.Write(util.EscapeHTML(node.tex))
+	w.WriteString("</span>")
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *mathRenderer) renderMathBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node := n.(*mathBlock)
+	if node.number > 0 {
+		fmt.Fprintf(w, `<div class="math display" id="%s">`, util.EscapeHTML([]byte(node.id)))
+	} else {
+		w.WriteString(`<div class="math display">`)
+	}
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		if i > 0 {
+			w.WriteByte('\n')
+		}
+		w.Write(util.EscapeHTML(util.TrimRightSpace(segment.Value(source))))
+	}
+
+	// KaTeX numbers the equation
+	if node.number > 0 {
+		fmt.Fprintf(w, " \\tag{%d}", node.number)
+	}
+	w.WriteString("</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// hasMath reports whether a parsed document contains math
+func hasMath(doc ast.Node) bool {
+	found := false
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n.Kind() == kindMath || n.Kind() == kindMathBlock {
+			found = true
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return found
+}
+
+// mathHead returns the elements that load KaTeX and render the math of a
+// document. The fonts are inlined as data URLs, so the document doesn't
+// depend on files next to it.
+func mathHead() (string, error) {
+	css, err := katexFS.ReadFile("katex/katex.css")
+	if err != nil {
+		return "", err
+	}
+	script, err := katexFS.ReadFile("katex/katex.min.js")
+	if err != nil {
+		return "", err
+	}
+
+	var fontErr error
+	styles := katexFontRegex.ReplaceAllStringFunc(string(css), func(url string) string {
+		name := katexFontRegex.FindStringSubmatch(url)[1]
+		font, err := katexFS.ReadFile(path.Join("katex", name))
+		if err != nil {
+			fontErr = err
+			return url
+		}
+		return "url(data:font/woff2;base64," + base64.StdEncoding.EncodeToString(font) + ")"
+	})
+	if fontErr != nil {
+		return "", fontErr
+	}
+
+	var b strings.Builder
+	b.WriteString("<style>\n" + styles + "</style>\n")
+	b.WriteString("<script>\n" + string(script) + "</script>\n")
+	b.WriteString("<script>\n" + mathScript + "</script>\n")
+	return b.String(), nil
+}
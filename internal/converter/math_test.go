@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMath(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "display block",
+			in:   "$$\nE = mc^2\n$$\n",
+			want: "<div class=\"math display\">E = mc^2</div>\n",
+		},
+		{
+			name: "display block on one line",
+			in:   "$$E = mc^2$$\n",
+			want: "<div class=\"math display\">E = mc^2</div>\n",
+		},
+		{
+			name: "display math starting a paragraph",
+			in:   "$$a$$ is inline\nnext line\n\nmore",
+			want: "<p><span class=\"math display\">a</span> is inline<br />\nnext line</p>\n<p>more</p>\n",
+		},
+		{
+			name: "two display formulas on a line",
+			in:   "$$a$$ and $$b$$\n",
+			want: "<p><span class=\"math display\">a</span> and <span class=\"math display\">b</span></p>\n",
+		},
+		{
+			name: "unterminated block",
+			in:   "$$\nx\n\nmore",
+			want: "<p>$$<br />\nx</p>\n<p>more</p>\n",
+		},
+		{
+			name: "inline",
+			in:   "Let $x$ be.\n",
+			want: "<p>Let <span class=\"math inline\">x</span> be.</p>\n",
+		},
+	}
+
+	md := newMarkdown(&rawHTMLExtension{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := md.Convert([]byte(tt.in), &b); err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"github.com/yuin/goldmark/ast"
+)
+
+// DefaultOutlineDepth is the deepest heading level included in the PDF
+// outline
+const DefaultOutlineDepth = 6
+
+// heading is a document heading with the ID assigned by the parser
+type heading struct {
+	Level int
+	ID    string
+	Title string
+}
+
+// outlineItem is a heading in the outline tree
+type outlineItem struct {
+	heading
+	kids []*outlineItem
+}
+
+// collectHeadings returns the headings of a parsed document that have an
+// ID, in document order
+func collectHeadings(doc ast.Node, source []byte) []heading {
+	var headings []heading
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if id, ok := h.AttributeString("id"); ok {
+			if id, ok := id.([]byte); ok {
+				headings = append(headings, heading{
+					Level: h.Level,
+					ID:    string(id),
+					Title: strings.TrimSpace(string(h.Text(source))),
+				})
+			}
+		}
+		return ast.WalkSkipChildren, nil
+	})
+
+	return headings
+}
+
+// outlineAnchors returns hidden links to the headings. Chrome only creates
+// named destinations for elements that are linked to, and the outline
+// points at those destinations.
+func outlineAnchors(headings []heading) string {
+	if len(headings) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(`<div class="pdfy-outline-anchors" style="display: none">`)
+	for _, h := range headings {
+		b.WriteString(`<a href="#` + html.EscapeString(h.ID) + `"></a>`)
+	}
+	b.WriteString("</div>\n")
+
+	return b.String()
+}
+
+// writeOutline adds a bookmark for every heading up to the configured
+// depth, replacing any outline the renderer created
+func (c *Converter) writeOutline(ctx *model.Context) error {
+	depth := c.config.OutlineDepth
+	if depth <= 0 || len(c.headings) == 0 {
+		return nil
+	}
+
+	dests, err := namedDestinations(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Nest each heading under the closest preceding heading of a higher
+	// level. Headings without a destination can't be linked to.
+	root := &outlineItem{}
+	stack := []*outlineItem{root}
+	for _, h := range c.headings {
+		if h.Level > depth || dests[h.ID] == nil {
+			continue
+		}
+		for len(stack) > 1 && stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
+
+		item := &outlineItem{heading: h}
+		parent := stack[len(stack)-1]
+		parent.kids = append(parent.kids, item)
+		stack = append(stack, item)
+	}
+
+	if len(root.kids) == 0 {
+		return nil
+	}
+
+	outlines := types.Dict{"Type": types.Name("Outlines")}
+	ref, err := ctx.IndRefForNewObject(outlines)
+	if err != nil {
+		return err
+	}
+
+	count, err := addOutlineItems(ctx, outlines, *ref, root.kids, dests)
+	if err != nil {
+		return err
+	}
+	outlines["Count"] = types.Integer(count)
+
+	catalog, err := ctx.Catalog()
+	if err != nil {
+		return err
+	}
+	catalog["Outlines"] = *ref
+	catalog["PageMode"] = types.Name("UseOutlines")
+
+	return nil
+}
+
+// addOutlineItems adds the items as children of the parent outline
+// dictionary and returns the number of items added, including descendants
+func addOutlineItems(ctx *model.Context, parent types.Dict, parentRef types.IndirectRef, items []*outlineItem, dests map[string]types.Array) (int, error) {
+	count := 0
+
+	var prev types.Dict
+	var prevRef types.IndirectRef
+	for _, item := range items {
+		title, err := types.EscapeUTF16String(item.Title)
+		if err != nil {
+			return 0, err
+		}
+
+		d := types.Dict{
+			"Title":  types.StringLiteral(*title),
+			"Parent": parentRef,
+			"Dest":   dests[item.ID],
+		}
+		ref, err := ctx.IndRefForNewObject(d)
+		if err != nil {
+			return 0, err
+		}
+
+		if prev == nil {
+			parent["First"] = *ref
+		} else {
+			prev["Next"] = *ref
+			d["Prev"] = prevRef
+		}
+		parent["Last"] = *ref
+
+		n, err := addOutlineItems(ctx, d, *ref, item.kids, dests)
+		if err != nil {
+			return 0, err
+		}
+		if n > 0 {
+			d["Count"] = types.Integer(n)
+		}
+
+		count += 1 + n
+		prev, prevRef = d, *ref
+	}
+
+	return count, nil
+}
+
+// namedDestinations returns the named destinations of the PDF as explicit
+// destination arrays, which outline items can use directly
+func namedDestinations(ctx *model.Context) (map[string]types.Array, error) {
+	dests := map[string]types.Array{}
+
+	add := func(name string, o types.Object) error {
+		o, err := ctx.Dereference(o)
+		if err != nil {
+			return err
+		}
+		// A destination may be wrapped in a dictionary with a D entry
+		if d, ok := o.(types.Dict); ok {
+			if o, err = ctx.Dereference(d["D"]); err != nil {
+				return err
+			}
+		}
+		if arr, ok := o.(types.Array); ok {
+			dests[name] = arr
+		}
+		return nil
+	}
+
+	// PDF 1.1 style Dests dictionary in the catalog
+	catalog, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+	if o, found := catalog.Find("Dests"); found {
+		d, err := ctx.DereferenceDict(o)
+		if err != nil {
+			return nil, err
+		}
+		for name, o := range d {
+			if err := add(name, o); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Dests name tree
+	if tree := ctx.Names["Dests"]; tree != nil {
+		err := tree.Process(ctx.XRefTable, func(_ *model.XRefTable, name string, o *types.Object) error {
+			return add(name, *o)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dests, nil
+}
+
+// destinationPages returns the page number of each named destination of a
+// PDF
+func destinationPages(pdf []byte) (map[string]int, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+	ctx, err := api.ReadValidateAndOptimize(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+	dests, err := namedDestinations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := map[string]int{}
+	for name, dest := range dests {
+		if len(dest) == 0 {
+			continue
+		}
+		ref, ok := dest[0].(types.IndirectRef)
+		if !ok {
+			continue
+		}
+		page, err := ctx.PageNumber(ref.ObjectNumber.Value())
+		if err != nil {
+			return nil, err
+		}
+		pages[name] = page
+	}
+
+	return pages, nil
+}
@@ -0,0 +1,173 @@
+package converter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// paperSizes maps paper size names to their portrait width and height in
+// inches
+var paperSizes = map[string][2]float64{
+	"a3":     {11.69, 16.54},
+	"a4":     {8.27, 11.69},
+	"a5":     {5.83, 8.27},
+	"letter": {8.5, 11},
+	"legal":  {8.5, 14},
+}
+
+// lengthUnits maps the supported length units to inches
+var lengthUnits = map[string]float64{
+	"mm": 1 / 25.4,
+	"cm": 1 / 2.54,
+	"in": 1,
+	"pt": 1.0 / 72,
+}
+
+const defaultMargin = "0.4in"
+
+// PaperSizeNames returns the names of the paper size presets
+func PaperSizeNames() []string {
+	names := make([]string, 0, len(paperSizes))
+	for name := range paperSizes {
+		names = append(names, strings.ToUpper(name[:1])+name[1:])
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parsePaperSize returns the width and height in inches of a paper size
+// preset such as "A4", or of a custom size such as "210mm x 297mm"
+func parsePaperSize(size string) (float64, float64, error) {
+	size = strings.ToLower(strings.TrimSpace(size))
+	if size == "" {
+		size = "a4"
+	}
+
+	if dims, ok := paperSizes[size]; ok {
+		return dims[0], dims[1], nil
+	}
+
+	width, height, found := strings.Cut(size, "x")
+	if !found {
+		return 0, 0, fmt.Errorf("unknown paper size %q (use %s or WIDTHxHEIGHT)", size, strings.Join(PaperSizeNames(), ", "))
+	}
+
+	w, err := parseLength(width)
+	if err != nil {
+		return 0, 0, err
+	}
+	h, err := parseLength(height)
+	if err != nil {
+		return 0, 0, err
+	}
+	if w <= 0 || h <= 0 {
+		return 0, 0, fmt.Errorf("invalid paper size %q", size)
+	}
+
+	return w, h, nil
+}
+
+// parseLength converts a length with a unit, such as "12.5mm", to inches
+func parseLength(length string) (float64, error) {
+	length = strings.ToLower(strings.TrimSpace(length))
+	if length == "0" {
+		return 0, nil
+	}
+
+	for unit, factor := range lengthUnits {
+		if value, found := strings.CutSuffix(length, unit); found {
+			v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid length %q", length)
+			}
+			return v * factor, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid length %q (use mm, cm, in or pt)", length)
+}
+
+// parseMargins expands a CSS style margin shorthand with one to four
+// lengths into top, right, bottom and left margins in inches
+func parseMargins(margin string) ([4]float64, error) {
+	var margins [4]float64
+
+	fields := strings.Fields(margin)
+	values := make([]float64, len(fields))
+	for i, field := range fields {
+		v, err := parseLength(field)
+		if err != nil {
+			return margins, err
+		}
+		values[i] = v
+	}
+
+	switch len(values) {
+	case 1:
+		margins = [4]float64{values[0], values[0], values[0], values[0]}
+	case 2:
+		margins = [4]float64{values[0], values[1], values[0], values[1]}
+	case 3:
+		margins = [4]float64{values[0], values[1], values[2], values[1]}
+	case 4:
+		margins = [4]float64{values[0], values[1], values[2], values[3]}
+	default:
+		return margins, fmt.Errorf("invalid margin %q", margin)
+	}
+
+	return margins, nil
+}
+
+// printOptions builds the print options from the configuration
+func (c *Converter) printOptions() (*PrintOptions, error) {
+	opts := DefaultPrintOptions()
+
+	width, height, err := parsePaperSize(c.config.PaperSize)
+	if err != nil {
+		return nil, err
+	}
+	opts.PaperWidth = width
+	opts.PaperHeight = height
+	opts.Landscape = c.config.Landscape
+
+	margin := c.config.Margin
+	if margin == "" {
+		margin = defaultMargin
+	}
+	margins, err := parseMargins(margin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Margins for a single side override the shorthand
+	sides := []string{c.config.MarginTop, c.config.MarginRight, c.config.MarginBottom, c.config.MarginLeft}
+	for i, side := range sides {
+		if side == "" {
+			continue
+		}
+		if margins[i], err = parseLength(side); err != nil {
+			return nil, err
+		}
+	}
+	opts.MarginTop = margins[0]
+	opts.MarginRight = margins[1]
+	opts.MarginBottom = margins[2]
+	opts.MarginLeft = margins[3]
+
+	if c.config.Scale != 0 {
+		if c.config.Scale < 0.1 || c.config.Scale > 2 {
+			return nil, fmt.Errorf("scale must be between 0.1 and 2, got %g", c.config.Scale)
+		}
+		opts.Scale = c.config.Scale
+	}
+
+	opts.PageRanges = c.config.PageRanges
+	opts.PreferCSSPageSize = c.config.PreferCSSPageSize
+	opts.Tagged = c.config.Tagged
+	opts.HeaderTemplate = c.header
+	opts.FooterTemplate = c.footer
+
+	return opts, nil
+}
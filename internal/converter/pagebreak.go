@@ -0,0 +1,233 @@
+package converter
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// pageBreakRegex matches a line that forces a page break: <!-- pagebreak -->,
+// \newpage or \pagebreak
+var pageBreakRegex = regexp.MustCompile(`^[ \t]*(?:(?i:<!--\s*pagebreak\s*-->)|\\newpage|\\pagebreak)[ \t]*\r?\n?$`)
+
+// kindPageBreak is the node kind of page breaks
+var kindPageBreak = ast.NewNodeKind("PageBreak")
+
+// pageBreak is a forced page break
+type pageBreak struct {
+	ast.BaseBlock
+}
+
+// Kind implements ast.Node
+func (n *pageBreak) Kind() ast.NodeKind {
+	return kindPageBreak
+}
+
+// Dump implements ast.Node
+func (n *pageBreak) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+// pageBreakExtension parses page break markers. The breaks are styled
+// inline, so that they work with every theme.
+type pageBreakExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *pageBreakExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(e, 700)),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// Trigger implements parser.BlockParser
+func (e *pageBreakExtension) Trigger() []byte {
+	return []byte{'<', '\\'}
+}
+
+// Open implements parser.BlockParser
+func (e *pageBreakExtension) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	if !pageBreakRegex.Match(line) {
+		return nil, parser.NoChildren
+	}
+
+	reader.Advance(lineLength(line, segment))
+	return &pageBreak{}, parser.NoChildren
+}
+
+// Continue implements parser.BlockParser
+func (e *pageBreakExtension) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return parser.Close
+}
+
+// Close implements parser.BlockParser
+func (e *pageBreakExtension) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph implements parser.BlockParser
+func (e *pageBreakExtension) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser
+func (e *pageBreakExtension) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *pageBreakExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindPageBreak, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			w.WriteString("<div class=\"page-break\" style=\"break-after: page\"></div>\n")
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+// HeadingLevelNames returns the heading levels accepted by PageBreakBefore
+func HeadingLevelNames() []string {
+	return []string{"h1", "h2", "h3", "h4", "h5", "h6"}
+}
+
+// pageBreakLevels returns which heading levels start a new page
+func (c *Converter) pageBreakLevels() ([7]bool, error) {
+	var levels [7]bool
+	for _, name := range c.config.PageBreakBefore {
+		var level int
+		if _, err := fmt.Sscanf(strings.ToLower(strings.TrimSpace(name)), "h%d", &level); err != nil || level < 1 || level > 6 {
+			return levels, fmt.Errorf("invalid heading level %q to break pages before (available: %s)",
+				name, strings.Join(HeadingLevelNames(), ", "))
+		}
+		levels[level] = true
+	}
+	return levels, nil
+}
+
+// breakPages makes headings start a new page according to PageBreakBefore,
+// and level 1 headings, which start chapters, start a right-hand page when
+// OddPageChapters is set
+func (c *Converter) breakPages(doc ast.Node) error {
+	levels, err := c.pageBreakLevels()
+	if err != nil {
+		return err
+	}
+
+	return ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch {
+		case heading.Level == 1 && c.config.OddPageChapters:
+			addStyle(heading, "break-before: right")
+			if id, ok := heading.AttributeString("id"); ok {
+				if id, ok := id.([]byte); ok {
+					c.chapters = append(c.chapters, string(id))
+				}
+			}
+		case levels[heading.Level]:
+			addStyle(heading, "break-before: page")
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// chapterBreak returns the style of a break before a chapter of a book
+func (c *Converter) chapterBreak() string {
+	if c.config.OddPageChapters {
+		return "break-before: right"
+	}
+	return "break-before: page"
+}
+
+// addStyle adds a CSS declaration to the style attribute of a node
+func addStyle(n ast.Node, declaration string) {
+	if style, ok := n.AttributeString("style"); ok {
+		if s, ok := style.([]byte); ok && len(s) > 0 {
+			declaration = strings.TrimRight(string(s), "; ") + "; " + declaration
+		}
+	}
+	n.SetAttributeString("style", []byte(declaration))
+}
+
+// blankPage is inserted before chapters that would start on a left-hand page
+const blankPage = `<div class="blank-page" style="break-before: page; break-after: page"></div>`
+
+// chapterAnchors returns hidden links to the chapters, so that the PDF has
+// named destinations that tell which page each chapter starts on
+func chapterAnchors(ids []string) string {
+	headings := make([]heading, len(ids))
+	for i, id := range ids {
+		headings[i] = heading{ID: id}
+	}
+	return outlineAnchors(headings)
+}
+
+// padChapters makes every chapter start on a right-hand page. Chrome breaks
+// pages before "break-before: right" as it does for "page", so the chapters
+// are looked up in the rendered PDF, and the HTML is rendered again with a
+// blank page before each chapter that starts on an even page. It returns
+// the HTML and PDF with the blank pages.
+func (c *Converter) padChapters(styledHTML string, pdf []byte) (string, []byte, error) {
+	if len(c.chapters) == 0 {
+		return styledHTML, pdf, nil
+	}
+
+	pages, err := destinationPages(pdf)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The first chapter starting on each page
+	starts := map[int]string{}
+	for _, id := range c.chapters {
+		page, ok := pages[id]
+		if !ok {
+			continue
+		}
+		if _, ok := starts[page]; !ok {
+			starts[page] = id
+		}
+	}
+	startPages := make([]int, 0, len(starts))
+	for page := range starts {
+		startPages = append(startPages, page)
+	}
+	sort.Ints(startPages)
+
+	// Every blank page moves the pages after it by one, as chapters start
+	// on a new page anyway
+	padded := styledHTML
+	blanks := 0
+	for _, page := range startPages {
+		if (page+blanks)%2 != 0 {
+			continue
+		}
+		attr := fmt.Sprintf(` id="%s"`, html.EscapeString(starts[page]))
+		i := strings.Index(padded, attr)
+		if i < 0 {
+			continue
+		}
+		i = strings.LastIndex(padded[:i], "<")
+		padded = padded[:i] + blankPage + padded[i:]
+		blanks++
+	}
+
+	if blanks == 0 {
+		return styledHTML, pdf, nil
+	}
+	pdf, err = c.htmlToPDF(padded)
+	return padded, pdf, err
+}
@@ -0,0 +1,215 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+func init() {
+	// pdfcpu would otherwise create a configuration directory in the
+	// user's home on first use
+	api.DisableConfigDir()
+}
+
+// Creator is written to the Creator and Producer fields of generated PDFs
+const Creator = "pdfy " + Version
+
+// postProcess applies the finishing steps to the PDF produced by the
+// renderer
+func (c *Converter) postProcess(pdf []byte, fm *FrontMatter) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+
+	ctx, err := api.ReadValidateAndOptimize(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+
+	if err := c.writeMetadata(ctx, fm); err != nil {
+		return nil, fmt.Errorf("failed to write metadata: %w", err)
+	}
+
+	if err := c.writeOutline(ctx); err != nil {
+		return nil, fmt.Errorf("failed to write outline: %w", err)
+	}
+
+	if err := c.addWatermark(ctx); err != nil {
+		return nil, fmt.Errorf("failed to add watermark: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := api.Write(ctx, &buf, conf); err != nil {
+		return nil, fmt.Errorf("failed to write PDF: %w", err)
+	}
+
+	// Encryption comes last so that it covers everything added above
+	if c.config.Encryption != nil {
+		pdf, err := encrypt(buf.Bytes(), c.config.Encryption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt PDF: %w", err)
+		}
+		return pdf, nil
+	}
+
+	pdf, err = setProducer(buf.Bytes(), "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to write metadata: %w", err)
+	}
+	return pdf, nil
+}
+
+// setProducer names pdfy as the producer of a PDF. pdfcpu names itself
+// whenever it writes a whole document, so this is done once the document is
+// written, in an incremental update that only replaces the information
+// dictionary.
+func setProducer(pdf []byte, userPassword, ownerPassword string) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+	conf.UserPW, conf.OwnerPW = userPassword, ownerPassword
+
+	ctx, err := api.ReadAndValidate(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Info == nil {
+		return pdf, nil
+	}
+	info, err := ctx.DereferenceDict(*ctx.Info)
+	if err != nil {
+		return nil, err
+	}
+	info["Producer"] = types.StringLiteral(Creator)
+
+	ctx.Write.Increment = true
+	ctx.Write.Offset = ctx.Read.FileSize
+	ctx.Write.IncrementWithObjNr(ctx.Info.ObjectNumber.Value())
+
+	buf := bytes.NewBuffer(pdf)
+	if err := api.WriteIncrement(ctx, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeMetadata fills the document information dictionary and the XMP
+// metadata stream from the front matter
+func (c *Converter) writeMetadata(ctx *model.Context, fm *FrontMatter) error {
+	info, err := infoDict(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]string{
+		"Title":    c.getTitle(fm),
+		"Author":   fm.Author,
+		"Subject":  fm.Subject,
+		"Keywords": strings.Join(fm.Keywords, ", "),
+		"Creator":  Creator,
+	}
+	for key, value := range fields {
+		if value == "" {
+			delete(info, key)
+			continue
+		}
+		s, err := types.EscapeUTF16String(value)
+		if err != nil {
+			return err
+		}
+		info[key] = types.StringLiteral(*s)
+	}
+
+	// Attach the XMP packet to the catalog
+	catalog, err := ctx.Catalog()
+	if err != nil {
+		return err
+	}
+
+	sd := types.StreamDict{
+		Dict: types.Dict{
+			"Type":    types.Name("Metadata"),
+			"Subtype": types.Name("XML"),
+		},
+		Content: []byte(c.xmpPacket(fm)),
+	}
+	if err := sd.Encode(); err != nil {
+		return err
+	}
+
+	ref, err := ctx.IndRefForNewObject(sd)
+	if err != nil {
+		return err
+	}
+	catalog["Metadata"] = *ref
+
+	if fm.Language != "" {
+		catalog["Lang"] = types.StringLiteral(fm.Language)
+	}
+
+	return nil
+}
+
+// infoDict returns the document information dictionary, creating it if
+// the PDF doesn't have one
+func infoDict(ctx *model.Context) (types.Dict, error) {
+	if ctx.Info == nil {
+		ref, err := ctx.IndRefForNewObject(types.NewDict())
+		if err != nil {
+			return nil, err
+		}
+		ctx.Info = ref
+	}
+
+	return ctx.DereferenceDict(*ctx.Info)
+}
+
+// xmpPacket returns the XMP metadata for the document
+func (c *Converter) xmpPacket(fm *FrontMatter) string {
+	now := time.Now().Format(time.RFC3339)
+
+	var b strings.Builder
+	b.WriteString("<?xpacket begin=\"\ufeff\" id=\"W5M0MpCehiHzreSzNTczkc9d\"?>\n")
+	b.WriteString(`<x:xmpmeta xmlns:x="adobe:ns:meta/">` + "\n")
+	b.WriteString(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` + "\n")
+	b.WriteString(`<rdf:Description rdf:about=""` +
+		` xmlns:dc="http://purl.org/dc/elements/1.1/"` +
+		` xmlns:xmp="http://ns.adobe.com/xap/1.0/"` +
+		` xmlns:pdf="http://ns.adobe.com/pdf/1.3/">` + "\n")
+
+	fmt.Fprintf(&b, "<dc:format>application/pdf</dc:format>\n")
+	fmt.Fprintf(&b, "<dc:title><rdf:Alt><rdf:li xml:lang=\"x-default\">%s</rdf:li></rdf:Alt></dc:title>\n", html.EscapeString(c.getTitle(fm)))
+	if fm.Author != "" {
+		fmt.Fprintf(&b, "<dc:creator><rdf:Seq><rdf:li>%s</rdf:li></rdf:Seq></dc:creator>\n", html.EscapeString(fm.Author))
+	}
+	if fm.Subject != "" {
+		fmt.Fprintf(&b, "<dc:description><rdf:Alt><rdf:li xml:lang=\"x-default\">%s</rdf:li></rdf:Alt></dc:description>\n", html.EscapeString(fm.Subject))
+	}
+	if len(fm.Keywords) > 0 {
+		b.WriteString("<dc:subject><rdf:Bag>")
+		for _, keyword := range fm.Keywords {
+			fmt.Fprintf(&b, "<rdf:li>%s</rdf:li>", html.EscapeString(keyword))
+		}
+		b.WriteString("</rdf:Bag></dc:subject>\n")
+		fmt.Fprintf(&b, "<pdf:Keywords>%s</pdf:Keywords>\n", html.EscapeString(strings.Join(fm.Keywords, ", ")))
+	}
+	if fm.Language != "" {
+		fmt.Fprintf(&b, "<dc:language><rdf:Bag><rdf:li>%s</rdf:li></rdf:Bag></dc:language>\n", html.EscapeString(fm.Language))
+	}
+
+	fmt.Fprintf(&b, "<xmp:CreatorTool>%s</xmp:CreatorTool>\n", Creator)
+	fmt.Fprintf(&b, "<xmp:CreateDate>%s</xmp:CreateDate>\n", now)
+	fmt.Fprintf(&b, "<xmp:ModifyDate>%s</xmp:ModifyDate>\n", now)
+	fmt.Fprintf(&b, "<xmp:MetadataDate>%s</xmp:MetadataDate>\n", now)
+	fmt.Fprintf(&b, "<pdf:Producer>%s</pdf:Producer>\n", Creator)
+
+	b.WriteString("</rdf:Description>\n</rdf:RDF>\n</x:xmpmeta>\n")
+	b.WriteString(`<?xpacket end="w"?>`)
+
+	return b.String()
+}
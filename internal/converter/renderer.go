@@ -0,0 +1,347 @@
+package converter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/cdproto/runtime"
+	"github.com/chromedp/chromedp"
+)
+
+// headTagRegex matches the start tag of the document head
+var headTagRegex = regexp.MustCompile(`(?i)<head\b[^>]*>`)
+
+// PrintOptions controls the page layout of the generated PDF. All lengths
+// are in inches, with the paper size given in portrait orientation.
+type PrintOptions struct {
+	PaperWidth      float64
+	PaperHeight     float64
+	Landscape       bool
+	MarginTop       float64
+	MarginBottom    float64
+	MarginLeft      float64
+	MarginRight     float64
+	Scale           float64
+	PrintBackground bool
+
+	// PageRanges selects the pages to print, such as "1-5, 8"
+	PageRanges string
+
+	// PreferCSSPageSize lets a size in the document's @page rule override
+	// the paper size
+	PreferCSSPageSize bool
+
+	// Tagged produces a tagged PDF with a structure tree for assistive
+	// technology
+	Tagged bool
+
+	// HeaderTemplate and FooterTemplate are printed in the top and bottom
+	// margins of every page, using Chrome's print template markup
+	HeaderTemplate string
+	FooterTemplate string
+}
+
+// DefaultPrintOptions returns A4 paper with small margins
+func DefaultPrintOptions() *PrintOptions {
+	return &PrintOptions{
+		PaperWidth:      8.27,
+		PaperHeight:     11.69,
+		MarginTop:       0.4,
+		MarginBottom:    0.4,
+		MarginLeft:      0.4,
+		MarginRight:     0.4,
+		Scale:           1,
+		PrintBackground: true,
+	}
+}
+
+// pageSize returns the width and height of the page in its orientation
+func (o *PrintOptions) pageSize() (float64, float64) {
+	if o.Landscape {
+		return o.PaperHeight, o.PaperWidth
+	}
+	return o.PaperWidth, o.PaperHeight
+}
+
+// Renderer turns a complete HTML document into PDF bytes. Relative
+// resources in the document are resolved against baseDir.
+type Renderer interface {
+	Render(html, baseDir string, opts *PrintOptions) ([]byte, error)
+}
+
+// renderers lists the renderer names accepted by NewRenderer
+var renderers = map[string]func(config *Config) Renderer{
+	"chrome": func(config *Config) Renderer {
+		return NewChromeRenderer(config.Browser, config.Chrome)
+	},
+	"wkhtmltopdf": func(*Config) Renderer {
+		return NewCommandRenderer("wkhtmltopdf")
+	},
+	"weasyprint": func(*Config) Renderer {
+		return NewCommandRenderer("weasyprint")
+	},
+}
+
+// RendererNames returns the names of the available renderers
+func RendererNames() []string {
+	names := make([]string, 0, len(renderers))
+	for name := range renderers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewRenderer creates the renderer selected by config.RendererName
+func NewRenderer(config *Config) (Renderer, error) {
+	name := config.RendererName
+	if name == "" {
+		name = "chrome"
+	}
+
+	newRenderer, ok := renderers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown renderer %q (available: %s)", name, strings.Join(RendererNames(), ", "))
+	}
+	return newRenderer(config), nil
+}
+
+// ChromeRenderer renders PDFs with headless Chrome
+type ChromeRenderer struct {
+	browser *BrowserPool
+	options BrowserOptions
+}
+
+// NewChromeRenderer creates a Chrome renderer. If browser is nil, a browser
+// configured by options is started and stopped for every document.
+func NewChromeRenderer(browser *BrowserPool, options BrowserOptions) *ChromeRenderer {
+	return &ChromeRenderer{browser: browser, options: options}
+}
+
+// Render implements Renderer
+func (r *ChromeRenderer) Render(html, baseDir string, opts *PrintOptions) ([]byte, error) {
+	// Use the shared browser if there is one
+	pool := r.browser
+	if pool == nil {
+		pool = NewBrowserPool(1, r.options)
+		defer pool.Close()
+	}
+
+	// A remote browser can't read our files, so the document is sent
+	// over the DevTools connection instead
+	var load chromedp.Action
+	if pool.Remote() {
+		load = setDocumentContent(html)
+	} else {
+		tempHTMLPath, cleanup, err := writeTempHTML(html, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+
+		load = chromedp.Navigate("file://" + filepath.ToSlash(tempHTMLPath))
+	}
+
+	var pdfBuffer []byte
+
+	// Load the document and generate PDF
+	err := pool.Run(30*time.Second,
+		load,
+		chromedp.WaitReady("body"),
+		waitRendered(),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			// Get PDF with custom options
+			buf, _, err := page.PrintToPDF().
+				WithPrintBackground(opts.PrintBackground).
+				WithPaperWidth(opts.PaperWidth).
+				WithPaperHeight(opts.PaperHeight).
+				WithLandscape(opts.Landscape).
+				WithMarginTop(opts.MarginTop).
+				WithMarginBottom(opts.MarginBottom).
+				WithMarginLeft(opts.MarginLeft).
+				WithMarginRight(opts.MarginRight).
+				WithScale(opts.Scale).
+				WithPageRanges(opts.PageRanges).
+				WithPreferCSSPageSize(opts.PreferCSSPageSize).
+				WithGenerateTaggedPDF(opts.Tagged).
+				WithDisplayHeaderFooter(opts.HeaderTemplate != "" || opts.FooterTemplate != "").
+				WithHeaderTemplate(headerFooterTemplate(opts.HeaderTemplate, opts)).
+				WithFooterTemplate(headerFooterTemplate(opts.FooterTemplate, opts)).
+				Do(ctx)
+			if err != nil {
+				return err
+			}
+
+			pdfBuffer = buf
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return pdfBuffer, nil
+}
+
+// waitRendered waits for scripts in the document that render content, such
+// as math, to finish. Such scripts add a promise to window.pdfyRendering.
+// Web fonts are waited for as well.
+func waitRendered() chromedp.Action {
+	const script = `Promise.all([document.fonts.ready, ...(window.pdfyRendering || [])]).then(() => true)`
+
+	var done bool
+	return chromedp.Evaluate(script, &done, func(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+		return p.WithAwaitPromise(true)
+	})
+}
+
+// setDocumentContent replaces the content of a blank page with html
+func setDocumentContent(html string) chromedp.Action {
+	return chromedp.Tasks{
+		chromedp.Navigate("about:blank"),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			frameTree, err := page.GetFrameTree().Do(ctx)
+			if err != nil {
+				return err
+			}
+			return page.SetDocumentContent(frameTree.Frame.ID, html).Do(ctx)
+		}),
+	}
+}
+
+// CommandRenderer renders PDFs by running an external HTML to PDF tool.
+// wkhtmltopdf and weasyprint are supported.
+type CommandRenderer struct {
+	// Name is the tool to run
+	Name string
+	// Path is the executable, looked up in PATH by default
+	Path string
+}
+
+// NewCommandRenderer creates a renderer for the named tool
+func NewCommandRenderer(name string) *CommandRenderer {
+	return &CommandRenderer{Name: name, Path: name}
+}
+
+// Render implements Renderer
+func (r *CommandRenderer) Render(html, baseDir string, opts *PrintOptions) ([]byte, error) {
+	var args []string
+	switch r.Name {
+	case "wkhtmltopdf":
+		tempHTMLPath, cleanup, err := writeTempHTML(html, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+
+		width, height := opts.pageSize()
+		args = []string{
+			"--quiet",
+			"--enable-local-file-access",
+			"--page-width", inches(width),
+			"--page-height", inches(height),
+			"--margin-top", inches(opts.MarginTop),
+			"--margin-bottom", inches(opts.MarginBottom),
+			"--margin-left", inches(opts.MarginLeft),
+			"--margin-right", inches(opts.MarginRight),
+			"--zoom", fmt.Sprintf("%g", opts.Scale),
+		}
+		if !opts.PrintBackground {
+			args = append(args, "--no-background")
+		}
+		args = append(args, tempHTMLPath, "-")
+		html = ""
+
+	case "weasyprint":
+		// WeasyPrint takes the page size from CSS only
+		html = injectCSS(html, pageCSS(opts))
+		args = []string{"--base-url", baseDir + string(filepath.Separator), "-", "-"}
+
+	default:
+		return nil, fmt.Errorf("unsupported renderer command: %s", r.Name)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(r.Path, args...)
+	cmd.Stdin = strings.NewReader(html)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", r.Name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// writeTempHTML writes the HTML to a new temporary directory, with a base
+// element so that relative resources resolve against baseDir. The returned
+// function removes the directory.
+func writeTempHTML(html, baseDir string) (string, func(), error) {
+	dir, err := os.MkdirTemp("", "pdfy-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	if baseDir != "" {
+		if abs, err := filepath.Abs(baseDir); err == nil {
+			html = injectBase(html, abs)
+		}
+	}
+
+	path := filepath.Join(dir, "document.html")
+	if err := os.WriteFile(path, []byte(html), 0o600); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to write temporary HTML file: %w", err)
+	}
+	return path, cleanup, nil
+}
+
+// injectBase adds a base element pointing at dir to the start of the
+// document head, before any element that loads a resource
+func injectBase(html, dir string) string {
+	base := `<base href="file://` + template.HTMLEscapeString(absoluteURL(dir+string(filepath.Separator), &url.URL{})) + `">`
+	if loc := headTagRegex.FindStringIndex(html); loc != nil {
+		return html[:loc[1]] + base + html[loc[1]:]
+	}
+	return base + html
+}
+
+// injectCSS adds a style element to the end of the document head
+func injectCSS(html, css string) string {
+	return injectHead(html, "<style>\n"+css+"</style>\n")
+}
+
+// injectHead adds elements to the end of the document head
+func injectHead(html, elements string) string {
+	if i := strings.Index(html, "</head>"); i >= 0 {
+		return html[:i] + elements + html[i:]
+	}
+	return elements + html
+}
+
+// pageCSS returns an @page rule matching the print options
+func pageCSS(opts *PrintOptions) string {
+	width, height := opts.pageSize()
+	return fmt.Sprintf("@page { size: %s %s; margin: %s %s %s %s; }\n",
+		inches(width), inches(height),
+		inches(opts.MarginTop), inches(opts.MarginRight),
+		inches(opts.MarginBottom), inches(opts.MarginLeft))
+}
+
+// inches formats a length in inches for CSS and command line tools
+func inches(v float64) string {
+	return fmt.Sprintf("%gin", v)
+}
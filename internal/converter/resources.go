@@ -0,0 +1,164 @@
+package converter
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// resolveResources points the relative image and link URLs of a document at
+// the files they name, relative to the Markdown file each line was read
+// from, so that they don't depend on where the renderer loads the HTML
+// from. Missing images are reported as warnings.
+func (c *Converter) resolveResources(doc ast.Node, source []byte) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Image:
+			n.Destination = []byte(c.imageURL(n, string(n.Destination), source))
+		case *ast.Link:
+			n.Destination = []byte(c.resourceURL(n, string(n.Destination), source))
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// imageURL returns the URL to load an image from. Local images are inlined
+// as data URLs when EmbedImages is set or the browser is remote and can't
+// read local files.
+func (c *Converter) imageURL(n ast.Node, destination string, source []byte) string {
+	path, u := c.localPath(n, []byte(destination), source)
+	if path == "" {
+		return destination
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		at, snippet := c.sourceLine(n, []byte(destination), source)
+		c.warn(at, fmt.Sprintf("image not found: %s", destination), snippet)
+		return destination
+	}
+
+	if !c.embedImages() {
+		return absoluteURL(path, u)
+	}
+
+	data, err := imageDataURL(path)
+	if err != nil {
+		at, snippet := c.sourceLine(n, []byte(destination), source)
+		c.warn(at, fmt.Sprintf("image not embedded: %v", err), snippet)
+		return absoluteURL(path, u)
+	}
+	return data
+}
+
+// embedImages reports whether local images are inlined as data URLs
+func (c *Converter) embedImages() bool {
+	return c.config.EmbedImages || c.config.Chrome.RemoteURL != "" ||
+		(c.config.Browser != nil && c.config.Browser.Remote())
+}
+
+// rawHTML returns the extension that renders the raw HTML of a document,
+// which is dropped unless RawHTML is set. Local images and other resources
+// in it are resolved like those written in Markdown.
+func (c *Converter) rawHTML(source []byte) *rawHTMLExtension {
+	if !c.config.RawHTML {
+		return &rawHTMLExtension{}
+	}
+
+	return &rawHTMLExtension{
+		sanitizer: newHTMLSanitizer(c.config.HTML),
+		resolve: func(n ast.Node, tag, attribute, url string) string {
+			if tag == "img" {
+				return c.imageURL(n, url, source)
+			}
+			return c.resourceURL(n, url, source)
+		},
+	}
+}
+
+// resourceURL returns the absolute URL of a local file, or the destination
+// unchanged if it isn't one
+func (c *Converter) resourceURL(n ast.Node, destination string, source []byte) string {
+	if path, u := c.localPath(n, []byte(destination), source); path != "" {
+		return absoluteURL(path, u)
+	}
+	return destination
+}
+
+// localPath returns the file a relative or absolute URL in a document
+// names, and the parsed URL. It returns "" for URLs with a scheme, such as
+// https:, and for links within the document.
+func (c *Converter) localPath(n ast.Node, destination, source []byte) (string, *url.URL) {
+	dest := string(destination)
+	if filepath.IsAbs(dest) {
+		return dest, &url.URL{}
+	}
+
+	u, err := url.Parse(dest)
+	if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" {
+		return "", nil
+	}
+
+	path := filepath.FromSlash(u.Path)
+	if filepath.IsAbs(path) {
+		return path, u
+	}
+
+	at, _ := c.sourceLine(n, destination, source)
+	if at.file == "" {
+		at.file = c.config.InputPath
+	}
+	abs, err := filepath.Abs(filepath.Join(filepath.Dir(at.file), path))
+	if err != nil {
+		return "", nil
+	}
+	return abs, u
+}
+
+// absoluteURL returns the URL of an absolute path, keeping the query and
+// fragment of the URL it was written as. The URL has no scheme, as
+// Markdown rendering drops file: URLs, and resolves against the file: URL
+// the renderer loads the HTML from.
+func absoluteURL(path string, u *url.URL) string {
+	slashed := filepath.ToSlash(path)
+	if !strings.HasPrefix(slashed, "/") {
+		// Windows drive letters
+		slashed = "/" + slashed
+	}
+	return (&url.URL{Path: slashed, RawQuery: u.RawQuery, Fragment: u.Fragment}).String()
+}
+
+// imageDataURL returns an image file as a data URL
+func imageDataURL(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	mediaType := mime.TypeByExtension(strings.ToLower(filepath.Ext(path)))
+	if mediaType == "" {
+		mediaType = http.DetectContentType(data)
+	}
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+
+	// The only image data URLs that Markdown rendering allows
+	switch mediaType {
+	case "image/png", "image/jpeg", "image/gif", "image/webp", "image/svg+xml":
+	default:
+		return "", fmt.Errorf("%s images can't be embedded", mediaType)
+	}
+
+	return "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,423 @@
+package converter
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// HTMLOptions extends the raw HTML allowed in Markdown when RawHTML is set.
+// In front matter:
+//
+//	raw_html: true
+//	html:
+//	  tags: [video, source]
+//	  attributes: [controls]
+type HTMLOptions struct {
+	// Tags are allowed in addition to the default ones
+	Tags []string `yaml:"tags"`
+	// Attributes are allowed on every tag in addition to the default ones,
+	// including event handlers such as onclick
+	Attributes []string `yaml:"attributes"`
+	// RemoteResources keeps images and other resources loaded from other
+	// hosts, which are removed by default
+	RemoteResources bool `yaml:"remote_resources"`
+}
+
+// merge adds the tags and attributes allowed by other
+func (o *HTMLOptions) merge(other *HTMLOptions) {
+	o.Tags = append(o.Tags, other.Tags...)
+	o.Attributes = append(o.Attributes, other.Attributes...)
+	o.RemoteResources = o.RemoteResources || other.RemoteResources
+}
+
+var (
+	// htmlTokenRegex matches a comment, a declaration or processing
+	// instruction, or a start or end tag with its name and attributes
+	htmlTokenRegex = regexp.MustCompile(`(?s)<!--.*?-->|<[!?][^>]*>|</?([a-zA-Z][a-zA-Z0-9:-]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	// htmlAttributeRegex matches an attribute with an optional value
+	htmlAttributeRegex = regexp.MustCompile(`([^\s"'>/=]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+)))?`)
+	// cssURLRegex matches the URLs in CSS declarations
+	cssURLRegex = regexp.MustCompile(`(?i)url\(\s*['"]?([^'")\s]*)`)
+	// cssStringRegex matches the quoted strings in CSS declarations, which
+	// some properties and functions take as URLs
+	cssStringRegex = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+	// cssImageSetRegex matches image-set() and its prefixed forms, which
+	// take URLs as plain strings
+	cssImageSetRegex = regexp.MustCompile(`(?i)image-set\(`)
+)
+
+// allowedTags are the tags kept in raw HTML by default
+var allowedTags = setOf(
+	"a", "abbr", "address", "article", "aside", "b", "bdi", "bdo", "blockquote", "br",
+	"caption", "cite", "code", "col", "colgroup", "dd", "del", "details", "dfn", "div",
+	"dl", "dt", "em", "figcaption", "figure", "footer", "h1", "h2", "h3", "h4", "h5",
+	"h6", "header", "hr", "i", "img", "ins", "kbd", "li", "mark", "nav", "ol", "p",
+	"pre", "q", "rp", "rt", "ruby", "s", "samp", "section", "small", "span", "strong",
+	"sub", "summary", "sup", "table", "tbody", "td", "tfoot", "th", "thead", "time",
+	"tr", "u", "ul", "var", "wbr",
+)
+
+// allowedAttributes are the attributes kept on every tag by default, besides
+// aria-* and data-* attributes. Tags that load scripts aren't allowed, so
+// src is safe.
+var allowedAttributes = setOf("id", "class", "title", "lang", "dir", "style", "align", "hidden", "role", "src", "width", "height")
+
+// tagAttributes are the attributes kept on some tags by default
+var tagAttributes = map[string]map[string]bool{
+	"a":          setOf("href", "name", "rel", "hreflang"),
+	"img":        setOf("srcset", "sizes", "alt"),
+	"td":         setOf("colspan", "rowspan", "headers", "valign"),
+	"th":         setOf("colspan", "rowspan", "headers", "scope", "valign"),
+	"col":        setOf("span"),
+	"colgroup":   setOf("span"),
+	"ol":         setOf("start", "reversed", "type"),
+	"ul":         setOf("type"),
+	"li":         setOf("value"),
+	"details":    setOf("open"),
+	"time":       setOf("datetime"),
+	"del":        setOf("cite", "datetime"),
+	"ins":        setOf("cite", "datetime"),
+	"q":          setOf("cite"),
+	"blockquote": setOf("cite"),
+}
+
+// hiddenContentTags are removed along with their content when they're not
+// allowed, as their content isn't meant to be read as text
+var hiddenContentTags = setOf(
+	"script", "style", "iframe", "object", "applet", "noscript", "noembed", "noframes",
+	"template", "textarea", "title", "xmp", "svg", "math",
+)
+
+// urlAttributes hold URLs, whose scheme is checked
+var urlAttributes = setOf("href", "src", "srcset", "cite", "action", "formaction", "poster", "background", "data", "xlink:href")
+
+// safeSchemes are the URL schemes allowed in raw HTML, besides data: URLs
+// of images
+var safeSchemes = setOf("", "http", "https", "mailto", "tel")
+
+// htmlSanitizer filters raw HTML against an allowlist of tags and
+// attributes. It removes scripts, event handlers, URLs with unsafe schemes
+// and, unless allowed, resources loaded from other hosts.
+type htmlSanitizer struct {
+	tags       map[string]bool
+	attributes map[string]bool
+	remote     bool
+
+	// resolve returns the URL to load a local resource from, given the tag
+	// and attribute it's in. It may be nil.
+	resolve func(tag, attribute, url string) string
+}
+
+// newHTMLSanitizer creates a sanitizer that allows the default tags and
+// attributes and those of the options
+func newHTMLSanitizer(opts HTMLOptions) *htmlSanitizer {
+	s := &htmlSanitizer{
+		tags:       map[string]bool{},
+		attributes: map[string]bool{},
+		remote:     opts.RemoteResources,
+	}
+	for tag := range allowedTags {
+		s.tags[tag] = true
+	}
+	for _, tag := range opts.Tags {
+		s.tags[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+	for _, attr := range opts.Attributes {
+		s.attributes[strings.ToLower(strings.TrimSpace(attr))] = true
+	}
+	return s
+}
+
+// sanitize returns an HTML fragment with what isn't allowed removed.
+// Comments are kept, unless browsers would end them early.
+func (s *htmlSanitizer) sanitize(fragment []byte) []byte {
+	var out bytes.Buffer
+
+	// skip is the tag whose content is being removed
+	skip := ""
+	pos := 0
+	for _, m := range htmlTokenRegex.FindAllSubmatchIndex(fragment, -1) {
+		if skip == "" {
+			// A stray < could start a tag together with what follows
+			out.Write(bytes.ReplaceAll(fragment[pos:m[0]], []byte("<"), []byte("&lt;")))
+		}
+		pos = m[1]
+
+		token := fragment[m[0]:m[1]]
+		if m[2] < 0 {
+			if skip == "" && safeComment(token) {
+				out.Write(token)
+			}
+			continue
+		}
+
+		name := strings.ToLower(string(fragment[m[2]:m[3]]))
+		closing := token[1] == '/'
+		attrs := fragment[m[4]:m[5]]
+		selfClosing := bytes.HasSuffix(bytes.TrimSpace(attrs), []byte("/"))
+
+		if skip != "" {
+			if closing && name == skip {
+				skip = ""
+			}
+			continue
+		}
+		if !s.tags[name] {
+			if !closing && !selfClosing && hiddenContentTags[name] {
+				skip = name
+			}
+			continue
+		}
+
+		if closing {
+			out.WriteString("</" + name + ">")
+			continue
+		}
+		out.WriteString("<" + name)
+		s.writeAttributes(&out, name, attrs)
+		if selfClosing {
+			out.WriteString(" /")
+		}
+		out.WriteString(">")
+	}
+	if skip == "" {
+		out.Write(bytes.ReplaceAll(fragment[pos:], []byte("<"), []byte("&lt;")))
+	}
+
+	return out.Bytes()
+}
+
+// safeComment reports whether a token is a comment that ends where it
+// appears to. Browsers end comments early at <!--> and <!---> and at --!>,
+// so what follows those would be read as markup.
+func safeComment(token []byte) bool {
+	if len(token) < 7 || !bytes.HasPrefix(token, []byte("<!--")) || !bytes.HasSuffix(token, []byte("-->")) {
+		return false
+	}
+	body := token[4 : len(token)-3]
+	return !bytes.HasPrefix(body, []byte(">")) && !bytes.HasPrefix(body, []byte("->")) &&
+		!bytes.Contains(body, []byte("--")) && !bytes.Contains(body, []byte("<!--"))
+}
+
+// writeAttributes writes the allowed attributes of a tag
+func (s *htmlSanitizer) writeAttributes(out *bytes.Buffer, tag string, attrs []byte) {
+	for _, m := range htmlAttributeRegex.FindAllSubmatch(attrs, -1) {
+		name := strings.ToLower(string(m[1]))
+		if !allowedAttributes[name] && !tagAttributes[tag][name] && !s.attributes[name] &&
+			!strings.HasPrefix(name, "aria-") && !strings.HasPrefix(name, "data-") {
+			continue
+		}
+
+		raw := m[0]
+		if !bytes.ContainsRune(raw, '=') {
+			out.WriteString(" " + name)
+			continue
+		}
+		value := html.UnescapeString(string(bytes.Join(m[2:], nil)))
+
+		switch {
+		case name == "style":
+			if !s.safeStyle(value) {
+				continue
+			}
+		case urlAttributes[name]:
+			var ok bool
+			if value, ok = s.url(tag, name, value); !ok {
+				continue
+			}
+		}
+		out.WriteString(" " + name + `="` + html.EscapeString(value) + `"`)
+	}
+}
+
+// url checks a URL attribute, and returns the URL to use
+func (s *htmlSanitizer) url(tag, attribute, value string) (string, bool) {
+	// Links to other hosts are fine, loading resources from them isn't
+	resource := attribute != "cite" && (attribute != "href" || (tag != "a" && tag != "area"))
+
+	if attribute != "srcset" {
+		return s.resourceURL(tag, attribute, value, resource)
+	}
+
+	// srcset lists URLs with their widths or pixel densities
+	candidates := strings.Split(value, ",")
+	for i, candidate := range candidates {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		u, ok := s.resourceURL(tag, attribute, fields[0], resource)
+		if !ok {
+			return "", false
+		}
+		fields[0] = u
+		candidates[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(candidates, ", "), true
+}
+
+// resourceURL checks a single URL, and resolves it if it's a local resource
+func (s *htmlSanitizer) resourceURL(tag, attribute, value string, resource bool) (string, bool) {
+	// Browsers ignore whitespace and control characters in schemes
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, value)
+
+	u, err := url.Parse(cleaned)
+	if err != nil {
+		return "", false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	switch {
+	case scheme == "data":
+		return value, resource && strings.HasPrefix(strings.ToLower(u.Opaque), "image/")
+	case !safeSchemes[scheme]:
+		return "", false
+	case u.Host != "":
+		return value, s.remote || !resource
+	}
+
+	if resource && s.resolve != nil && u.Path != "" {
+		return s.resolve(tag, attribute, value), true
+	}
+	return value, true
+}
+
+// safeStyle reports whether CSS declarations load nothing from other hosts.
+// Escapes, which could hide a url(), and image-set() are rejected, as are
+// quoted strings that look like remote URLs.
+func (s *htmlSanitizer) safeStyle(style string) bool {
+	if strings.Contains(style, `\`) || cssImageSetRegex.MatchString(style) {
+		return false
+	}
+
+	for _, m := range cssURLRegex.FindAllStringSubmatch(style, -1) {
+		if !s.safeCSSURL(m[1]) {
+			return false
+		}
+	}
+	for _, m := range cssStringRegex.FindAllStringSubmatch(style, -1) {
+		if value := m[1] + m[2]; strings.Contains(value, "//") && !s.safeCSSURL(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// safeCSSURL reports whether a URL in CSS is local, a data: URL, or remote
+// with remote resources allowed
+func (s *htmlSanitizer) safeCSSURL(value string) bool {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+	switch scheme := strings.ToLower(u.Scheme); {
+	case scheme == "data":
+	case scheme == "" && u.Host == "":
+	case (scheme == "" || scheme == "http" || scheme == "https") && s.remote:
+	default:
+		return false
+	}
+	return true
+}
+
+// rawHTMLExtension renders the raw HTML in Markdown through a sanitizer, or
+// drops it when the sanitizer is nil. Comments are kept either way, as
+// they're harmless and some are markers, such as <!-- TOC -->.
+type rawHTMLExtension struct {
+	sanitizer *htmlSanitizer
+	// resolve returns the URL to load a local resource of a node from
+	resolve func(n ast.Node, tag, attribute, url string) string
+}
+
+// Extend implements goldmark.Extender
+func (e *rawHTMLExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *rawHTMLExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHTMLBlock, e.renderHTMLBlock)
+	reg.Register(ast.KindRawHTML, e.renderRawHTML)
+}
+
+func (e *rawHTMLExtension) renderHTMLBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	node := n.(*ast.HTMLBlock)
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	var b bytes.Buffer
+	for i := 0; i < node.Lines().Len(); i++ {
+		line := node.Lines().At(i)
+		b.Write(line.Value(source))
+	}
+	if node.HasClosure() {
+		b.Write(node.ClosureLine.Value(source))
+	}
+
+	e.write(w, n, b.Bytes())
+	if e.sanitizer == nil {
+		w.WriteString("\n")
+	}
+	return ast.WalkContinue, nil
+}
+
+func (e *rawHTMLExtension) renderRawHTML(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+
+	node := n.(*ast.RawHTML)
+	var b bytes.Buffer
+	for i := 0; i < node.Segments.Len(); i++ {
+		segment := node.Segments.At(i)
+		b.Write(segment.Value(source))
+	}
+
+	e.write(w, n, b.Bytes())
+	return ast.WalkSkipChildren, nil
+}
+
+// write writes sanitized raw HTML, or only a comment when raw HTML isn't
+// allowed
+func (e *rawHTMLExtension) write(w util.BufWriter, n ast.Node, raw []byte) {
+	if e.sanitizer != nil {
+		if e.resolve != nil {
+			e.sanitizer.resolve = func(tag, attribute, url string) string {
+				return e.resolve(n, tag, attribute, url)
+			}
+		}
+		w.Write(e.sanitizer.sanitize(raw))
+		return
+	}
+
+	comment := bytes.TrimSpace(raw)
+	if safeComment(comment) {
+		w.Write(comment)
+	} else {
+		w.WriteString("<!-- raw HTML omitted -->")
+	}
+}
+
+// setOf returns a set of strings
+func setOf(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
@@ -0,0 +1,142 @@
+package converter
+
+import "testing"
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		opts HTMLOptions
+		in   string
+		want string
+	}{
+		{
+			name: "allowed tags",
+			in:   `<p class="x">Hi <b>there</b></p>`,
+			want: `<p class="x">Hi <b>there</b></p>`,
+		},
+		{
+			name: "script with content",
+			in:   `a<script>alert(1)</script>b`,
+			want: `ab`,
+		},
+		{
+			name: "event handler",
+			in:   `<img src="https://example.com/a.png" onerror="alert(1)">`,
+			want: `<img>`,
+		},
+		{
+			name: "javascript link",
+			in:   `<a href="java&#x09;script:alert(1)">x</a>`,
+			want: `<a>x</a>`,
+		},
+		{
+			name: "comment",
+			in:   `<!-- TOC -->`,
+			want: `<!-- TOC -->`,
+		},
+		{
+			name: "comment ended by <!-->",
+			in:   `<!--><script>alert(1)</script>-->`,
+			want: ``,
+		},
+		{
+			name: "comment ended by <!--->",
+			in:   `<!---><img src=x onerror=alert(1)>-->`,
+			want: ``,
+		},
+		{
+			name: "comment ended by --!>",
+			in:   `<!-- --!><img src=x onerror=alert(1)> -->`,
+			want: ``,
+		},
+		{
+			name: "comment with --",
+			in:   `<!-- a -- b -->`,
+			want: ``,
+		},
+		{
+			name: "unterminated comment",
+			in:   `<!--> <b>x</b>`,
+			want: ` <b>x</b>`,
+		},
+		{
+			name: "remote url() in style",
+			in:   `<span style="background: url('https://evil/x.png')">x</span>`,
+			want: `<span>x</span>`,
+		},
+		{
+			name: "local url() in style",
+			in:   `<span style="background: url(x.png)">x</span>`,
+			want: `<span style="background: url(x.png)">x</span>`,
+		},
+		{
+			name: "image-set in style",
+			in:   `<span style="background-image: image-set('https://evil/x.png' 1x)">x</span>`,
+			want: `<span>x</span>`,
+		},
+		{
+			name: "prefixed image-set in style",
+			in:   `<span style="background-image: -webkit-image-set(&quot;x.png&quot; 1x)">x</span>`,
+			want: `<span>x</span>`,
+		},
+		{
+			name: "quoted remote URL in style",
+			in:   `<span style="cursor: '//evil/x.cur'">x</span>`,
+			want: `<span>x</span>`,
+		},
+		{
+			name: "escaped url() in style",
+			in:   `<span style="background: u\72l(https://evil/x.png)">x</span>`,
+			want: `<span>x</span>`,
+		},
+		{
+			name: "quoted font name in style",
+			in:   `<span style="font-family: 'Times New Roman'">x</span>`,
+			want: `<span style="font-family: &#39;Times New Roman&#39;">x</span>`,
+		},
+		{
+			name: "remote resources allowed",
+			opts: HTMLOptions{RemoteResources: true},
+			in:   `<span style="background: url(https://example.com/x.png)">x</span>`,
+			want: `<span style="background: url(https://example.com/x.png)">x</span>`,
+		},
+		{
+			name: "extra tags and attributes",
+			opts: HTMLOptions{Tags: []string{"video"}, Attributes: []string{"controls"}},
+			in:   `<video controls src="a.mp4"></video>`,
+			want: `<video controls src="a.mp4"></video>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(newHTMLSanitizer(tt.opts).sanitize([]byte(tt.in)))
+			if got != tt.want {
+				t.Errorf("sanitize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSafeComment(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"<!-- TOC -->", true},
+		{"<!---->", true},
+		{"<!-->", false},
+		{"<!--->", false},
+		{"<!-->-->", false},
+		{"<!--->-->", false},
+		{"<!-- a --!> b -->", false},
+		{"<!-- <!-- -->", false},
+		{"<!-- a", false},
+	}
+
+	for _, tt := range tests {
+		if got := safeComment([]byte(tt.in)); got != tt.want {
+			t.Errorf("safeComment(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
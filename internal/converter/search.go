@@ -0,0 +1,198 @@
+package converter
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// projectDirName is the directory of a project's templates and themes, in
+// the directory of the Markdown files or above it
+const projectDirName = ".pdfy"
+
+// resourceKind describes templates or themes, which are looked up by name
+// in the search directories before the built-in ones
+type resourceKind struct {
+	name string
+	// dir is the subdirectory of the project and user directories, and of
+	// the embedded files
+	dir string
+	ext string
+	fs  embed.FS
+}
+
+var (
+	templateKind = resourceKind{name: "template", dir: "templates", ext: ".html", fs: templatesFS}
+	themeKind    = resourceKind{name: "theme", dir: "themes", ext: ".css", fs: themesFS}
+)
+
+// isResourcePath reports whether a template or theme is given as a file
+// path rather than a name
+func isResourcePath(name string, kind resourceKind) bool {
+	return strings.ContainsAny(name, `/\`) || strings.EqualFold(filepath.Ext(name), kind.ext)
+}
+
+// searchDirs returns the directories searched for templates or themes, in
+// order: TemplateDirs, the project's .pdfy directory, and pdfy's directory
+// in the user's config directory
+func (c *Converter) searchDirs(kind resourceKind) []string {
+	dirs := append([]string(nil), c.config.TemplateDirs...)
+	if dir := projectDir(c.config.InputPath); dir != "" {
+		dirs = append(dirs, filepath.Join(dir, kind.dir))
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		dirs = append(dirs, filepath.Join(dir, "pdfy", kind.dir))
+	}
+	return dirs
+}
+
+// projectDir returns the closest .pdfy directory at or above the directory
+// of a Markdown file, or ""
+func projectDir(inputPath string) string {
+	dir, err := filepath.Abs(filepath.Dir(inputPath))
+	if err != nil {
+		return ""
+	}
+
+	for {
+		candidate := filepath.Join(dir, projectDirName)
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// loadResource returns a template or theme given by path or by name. Names
+// are looked up in the search directories, then among the built-in ones.
+func (c *Converter) loadResource(kind resourceKind, name string) (string, error) {
+	if isResourcePath(name, kind) {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", kind.name, err)
+		}
+		return string(content), nil
+	}
+
+	for _, dir := range c.searchDirs(kind) {
+		content, err := os.ReadFile(filepath.Join(dir, name+kind.ext))
+		if err == nil {
+			return string(content), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("failed to read %s: %w", kind.name, err)
+		}
+	}
+
+	if content, err := kind.fs.ReadFile(kind.dir + "/" + name + kind.ext); err == nil {
+		return string(content), nil
+	}
+
+	var names []string
+	for _, resource := range c.resources(kind) {
+		names = append(names, resource.Name)
+	}
+	msg := fmt.Sprintf("unknown %s %q", kind.name, name)
+	if match := closestName(name, names); match != "" {
+		msg += fmt.Sprintf(", did you mean %q?", match)
+	}
+	return "", fmt.Errorf("%s (available: %s)", msg, strings.Join(names, ", "))
+}
+
+// Resource is a template or theme that can be selected by name
+type Resource struct {
+	Name string
+	// Path is the file of a user's template or theme, and empty for the
+	// built-in ones
+	Path string
+}
+
+// Templates returns the templates that can be selected by name, sorted by
+// name
+func (c *Converter) Templates() []Resource {
+	return c.resources(templateKind)
+}
+
+// Themes returns the themes that can be selected by name, sorted by name
+func (c *Converter) Themes() []Resource {
+	return c.resources(themeKind)
+}
+
+// resources returns the templates or themes in the search directories and
+// the built-in ones, sorted by name. Of those with the same name, only the
+// one found first is returned.
+func (c *Converter) resources(kind resourceKind) []Resource {
+	var resources []Resource
+	seen := map[string]bool{}
+	add := func(dir string, entries []fs.DirEntry) {
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), kind.ext)
+			if entry.IsDir() || name == entry.Name() || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			resource := Resource{Name: name}
+			if dir != "" {
+				resource.Path = filepath.Join(dir, entry.Name())
+			}
+			resources = append(resources, resource)
+		}
+	}
+
+	for _, dir := range c.searchDirs(kind) {
+		entries, _ := os.ReadDir(dir)
+		add(dir, entries)
+	}
+	entries, _ := kind.fs.ReadDir(kind.dir)
+	add("", entries)
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Name < resources[j].Name
+	})
+	return resources
+}
+
+// closestName returns the name most like the given one, or "" if none is
+// close enough to be a typo
+func closestName(name string, names []string) string {
+	best, bestDistance := "", max(2, len(name)/3)+1
+	for _, candidate := range names {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		cur := make([]int, len(t)+1)
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(t)]
+}
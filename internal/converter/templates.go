@@ -3,8 +3,12 @@ package converter
 import (
 	"embed"
 	"fmt"
+	"html/template"
 	"os"
 	"strings"
+	"time"
+
+	"github.com/yuin/goldmark/ast"
 )
 
 //go:embed templates/*
@@ -13,21 +17,16 @@ var templatesFS embed.FS
 //go:embed themes/*
 var themesFS embed.FS
 
-// loadTemplate loads the HTML template based on the configuration
+// technicalTemplate is the built-in template for technical documents
+const technicalTemplate = "technical"
+
+// loadTemplate loads the HTML template named by the configuration
 func (c *Converter) loadTemplate() (string, error) {
 	templateName := c.config.TemplateName
 	if templateName == "" {
 		templateName = "default"
 	}
-
-	// Try to load from embedded templates first
-	templatePath := fmt.Sprintf("templates/%s.html", templateName)
-	if content, err := templatesFS.ReadFile(templatePath); err == nil {
-		return string(content), nil
-	}
-
-	// Fall back to basic template
-	return c.getDefaultTemplate(), nil
+	return c.loadResource(templateKind, templateName)
 }
 
 // loadCSS loads CSS styles based on theme and custom CSS
@@ -40,15 +39,12 @@ func (c *Converter) loadCSS() (string, error) {
 		theme = "light"
 	}
 
-	themePath := fmt.Sprintf("themes/%s.css", theme)
-	if themeCSS, err := themesFS.ReadFile(themePath); err == nil {
-		cssBuilder.Write(themeCSS)
-		cssBuilder.WriteString("\n")
-	} else {
-		// Fall back to default styles
-		cssBuilder.WriteString(c.getDefaultCSS())
-		cssBuilder.WriteString("\n")
+	themeCSS, err := c.loadResource(themeKind, theme)
+	if err != nil {
+		return "", err
 	}
+	cssBuilder.WriteString(themeCSS)
+	cssBuilder.WriteString("\n")
 
 	// Load custom CSS if provided
 	if c.config.CSSPath != "" {
@@ -62,353 +58,185 @@ func (c *Converter) loadCSS() (string, error) {
 	return cssBuilder.String(), nil
 }
 
-// getDefaultTemplate returns a basic HTML template
-func (c *Converter) getDefaultTemplate() string {
-	return `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>{{TITLE}}</title>
-    <meta name="author" content="{{AUTHOR}}">
-    <style>
-        {{CSS}}
-    </style>
-</head>
-<body>
-    <div class="document">
-        <header class="page-header">
-            {{HEADER}}
-        </header>
-        
-        <main class="content">
-            <div class="title-page">
-                <h1 class="document-title">{{TITLE}}</h1>
-                <p class="document-author">{{AUTHOR}}</p>
-                <p class="document-date">{{DATE}}</p>
-            </div>
-            
-            <div class="document-content">
-                {{CONTENT}}
-            </div>
-        </main>
-        
-        <footer class="page-footer">
-            {{FOOTER}}
-        </footer>
-    </div>
-</body>
-</html>`
-}
-
-// getDefaultCSS returns basic CSS styles
-func (c *Converter) getDefaultCSS() string {
-	return `
-/* Base styles */
-* {
-    box-sizing: border-box;
-}
-
-body {
-    font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', 'Roboto', 'Oxygen', 'Ubuntu', 'Cantarell', sans-serif;
-    line-height: 1.6;
-    color: #333;
-    margin: 0;
-    padding: 20px;
-    background: white;
-}
-
-.document {
-    max-width: 210mm;
-    margin: 0 auto;
-    background: white;
-    box-shadow: 0 0 10px rgba(0,0,0,0.1);
-    padding: 40px;
-}
-
-/* Typography */
-h1, h2, h3, h4, h5, h6 {
-    margin-top: 2em;
-    margin-bottom: 1em;
-    line-height: 1.2;
-    page-break-after: avoid;
-}
-
-h1 { font-size: 2.5em; color: #2c3e50; }
-h2 { font-size: 2em; color: #34495e; border-bottom: 2px solid #eee; padding-bottom: 0.3em; }
-h3 { font-size: 1.5em; color: #34495e; }
-h4 { font-size: 1.2em; }
-h5 { font-size: 1.1em; }
-h6 { font-size: 1em; }
-
-p {
-    margin-bottom: 1em;
-    text-align: justify;
-}
-
-/* Code blocks */
-code {
-    font-family: 'SFMono-Regular', 'Consolas', 'Liberation Mono', 'Menlo', monospace;
-    background-color: #f8f9fa;
-    padding: 2px 6px;
-    border-radius: 3px;
-    font-size: 0.9em;
-}
-
-pre {
-    background-color: #f8f9fa;
-    border: 1px solid #e9ecef;
-    border-radius: 6px;
-    padding: 16px;
-    overflow-x: auto;
-    margin: 1em 0;
-    page-break-inside: avoid;
-}
-
-pre code {
-    background: none;
-    padding: 0;
-    border-radius: 0;
-}
-
-/* Tables */
-table {
-    width: 100%;
-    border-collapse: collapse;
-    margin: 1em 0;
-    page-break-inside: avoid;
-}
-
-th, td {
-    border: 1px solid #ddd;
-    padding: 12px;
-    text-align: left;
-}
-
-th {
-    background-color: #f8f9fa;
-    font-weight: 600;
-}
-
-tr:nth-child(even) {
-    background-color: #f8f9fa;
-}
-
-/* Lists */
-ul, ol {
-    margin: 1em 0;
-    padding-left: 2em;
-}
-
-li {
-    margin-bottom: 0.5em;
-}
-
-/* Blockquotes */
-blockquote {
-    margin: 1em 0;
-    padding: 0 1em;
-    border-left: 4px solid #3498db;
-    background-color: #f8f9fa;
-    font-style: italic;
-}
-
-/* Links */
-a {
-    color: #3498db;
-    text-decoration: none;
-}
-
-a:hover {
-    text-decoration: underline;
-}
-
-/* Images */
-img {
-    max-width: 100%;
-    height: auto;
-    display: block;
-    margin: 1em auto;
-}
-
-/* Table of Contents */
-.toc {
-    background-color: #f8f9fa;
-    border: 1px solid #e9ecef;
-    border-radius: 6px;
-    padding: 20px;
-    margin: 2em 0;
-    page-break-inside: avoid;
-}
-
-.toc h2 {
-    margin-top: 0;
-    margin-bottom: 1em;
-    border-bottom: none;
-}
-
-.toc ul {
-    list-style: none;
-    padding-left: 0;
-}
-
-.toc li {
-    margin-bottom: 0.3em;
-}
-
-.toc a {
-    text-decoration: none;
-    color: #333;
-}
-
-.toc a:hover {
-    color: #3498db;
-}
-
-/* Title page */
-.title-page {
-    text-align: center;
-    margin-bottom: 3em;
-    page-break-after: always;
-}
+// wordsPerMinute is the reading speed ReadingMinutes is based on
+const wordsPerMinute = 200
+
+// TemplateData is what templates are executed with, as in {{.Title}} or
+// {{.Meta.version}}. The placeholders of older templates, {{TITLE}},
+// {{AUTHOR}}, {{DATE}}, {{CSS}}, {{CONTENT}}, {{HEADER}} and {{FOOTER}},
+// are functions returning the same values.
+type TemplateData struct {
+	Title    string
+	Subtitle string
+	Author   string
+	Date     string
+	Subject  string
+	Keywords []string
+	Language string
+	// Meta holds every front matter field by its key, including custom
+	// ones
+	Meta map[string]any
+
+	// CSS is the theme and custom CSS
+	CSS template.CSS
+	// Content is the document rendered to HTML
+	Content template.HTML
+	// Header and Footer are printed in the page margins by the renderer,
+	// so templates get them empty
+	Header template.HTML
+	Footer template.HTML
+
+	// Cover is nil unless the document has a cover page, which the
+	// template's "cover" template renders as a page of its own
+	Cover *Cover
+
+	// Outline is the tree of the document's headings
+	Outline []*OutlineEntry
+	Stats   DocumentStats
+	Build   BuildInfo
+}
+
+// OutlineEntry is a heading of the document with the headings below it
+type OutlineEntry struct {
+	Level    int
+	ID       string
+	Title    string
+	Children []*OutlineEntry
+}
+
+// DocumentStats counts what the document contains
+type DocumentStats struct {
+	Words          int
+	ReadingMinutes int
+	Headings       int
+	Figures        int
+	Tables         int
+	Equations      int
+	Citations      int
+}
+
+// BuildInfo describes the conversion
+type BuildInfo struct {
+	// Generator is the name and version of pdfy
+	Generator string
+	Version   string
+	Time      time.Time
+	// Source is the path of the Markdown file
+	Source string
+}
+
+// templateData returns the data for the template of a document
+func (c *Converter) templateData(fm *FrontMatter, css, content string) *TemplateData {
+	meta := fm.Meta
+	if meta == nil {
+		meta = map[string]any{}
+	}
 
-.document-title {
-    font-size: 3em;
-    margin-bottom: 0.5em;
-    color: #2c3e50;
+	return &TemplateData{
+		Title:    c.getTitle(fm),
+		Subtitle: fm.Subtitle,
+		Author:   fm.Author,
+		Date:     getDate(fm),
+		Subject:  fm.Subject,
+		Keywords: fm.Keywords,
+		Language: fm.Language,
+		Meta:     meta,
+
+		CSS:     template.CSS(css),
+		Content: template.HTML(content),
+
+		Cover:   c.cover(fm),
+		Outline: outlineEntries(c.headings),
+		Stats: DocumentStats{
+			Words:          c.words,
+			ReadingMinutes: (c.words + wordsPerMinute - 1) / wordsPerMinute,
+			Headings:       len(c.headings),
+			Figures:        len(c.figures),
+			Tables:         len(c.tables),
+			Equations:      c.equations,
+			Citations:      len(c.cited),
+		},
+		Build: BuildInfo{
+			Generator: Creator,
+			Version:   Version,
+			Time:      c.stats.StartTime,
+			Source:    c.config.InputPath,
+		},
+	}
 }
 
-.document-author {
-    font-size: 1.2em;
-    color: #7f8c8d;
-    margin-bottom: 0.5em;
-}
+// executeTemplate parses and executes an HTML template. When the data has a
+// cover, it also returns the cover page, from the template's "cover"
+// template or the default template's.
+func executeTemplate(name, text string, data *TemplateData) (string, string, error) {
+	placeholders := template.FuncMap{
+		"TITLE":   func() string { return data.Title },
+		"AUTHOR":  func() string { return data.Author },
+		"DATE":    func() string { return data.Date },
+		"CSS":     func() template.CSS { return data.CSS },
+		"CONTENT": func() template.HTML { return data.Content },
+		"HEADER":  func() template.HTML { return data.Header },
+		"FOOTER":  func() template.HTML { return data.Footer },
+	}
 
-.document-date {
-    color: #95a5a6;
-}
+	tmpl, err := template.New(name).Funcs(placeholders).Parse(text)
+	if err != nil {
+		return "", "", err
+	}
 
-/* Headers and footers */
-.page-header, .page-footer {
-    font-size: 0.9em;
-    color: #7f8c8d;
-    text-align: center;
-    padding: 10px 0;
-}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", "", err
+	}
+	if data.Cover == nil {
+		return b.String(), "", nil
+	}
 
-.page-header {
-    border-bottom: 1px solid #eee;
-    margin-bottom: 2em;
-}
+	if tmpl.Lookup(coverTemplate) == nil {
+		defaultText, err := templatesFS.ReadFile("templates/default.html")
+		if err != nil {
+			return "", "", err
+		}
+		// The header and footer tokens aren't template actions
+		text, _, _ := extractHeaderFooter(string(defaultText))
+		if tmpl, err = template.New("default").Funcs(placeholders).Parse(text); err != nil {
+			return "", "", err
+		}
+	}
 
-.page-footer {
-    border-top: 1px solid #eee;
-    margin-top: 2em;
+	var cover strings.Builder
+	if err := tmpl.ExecuteTemplate(&cover, coverTemplate, data); err != nil {
+		return "", "", err
+	}
+	return b.String(), cover.String(), nil
 }
 
-/* Print styles */
-@media print {
-    body {
-        padding: 0;
-    }
-    
-    .document {
-        box-shadow: none;
-        max-width: none;
-        padding: 0;
-    }
-    
-    /* Page breaks */
-    h1, h2, h3, h4, h5, h6 {
-        page-break-after: avoid;
-    }
-    
-    pre, blockquote, table, .toc {
-        page-break-inside: avoid;
-    }
-    
-    /* Hide elements that shouldn't print */
-    .no-print {
-        display: none !important;
-    }
-}
+// outlineEntries nests each heading under the closest preceding heading of
+// a higher level
+func outlineEntries(headings []heading) []*OutlineEntry {
+	root := &OutlineEntry{}
+	stack := []*OutlineEntry{root}
+	for _, h := range headings {
+		for len(stack) > 1 && stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
 
-/* Page numbering for PDF */
-@page {
-    margin: 2.5cm;
-    
-    @bottom-center {
-        content: counter(page) " / " counter(pages);
-        font-size: 9pt;
-        color: #666;
-    }
+		entry := &OutlineEntry{Level: h.Level, ID: h.ID, Title: h.Title}
+		parent := stack[len(stack)-1]
+		parent.Children = append(parent.Children, entry)
+		stack = append(stack, entry)
+	}
+	return root.Children
 }
 
-/* Syntax highlighting styles */
-.chroma .err { color: #a61717; background-color: #e3d2d2 }
-.chroma .k { color: #000000; font-weight: bold }
-.chroma .ch { color: #999988; font-style: italic }
-.chroma .cm { color: #999988; font-style: italic }
-.chroma .cp { color: #999999; font-weight: bold }
-.chroma .cpf { color: #999988; font-style: italic }
-.chroma .c1 { color: #999988; font-style: italic }
-.chroma .cs { color: #999999; font-weight: bold; font-style: italic }
-.chroma .gd { color: #000000; background-color: #ffdddd }
-.chroma .ge { color: #000000; font-style: italic }
-.chroma .gr { color: #aa0000 }
-.chroma .gh { color: #999999 }
-.chroma .gi { color: #000000; background-color: #ddffdd }
-.chroma .go { color: #888888 }
-.chroma .gp { color: #555555 }
-.chroma .gs { font-weight: bold }
-.chroma .gu { color: #aaaaaa }
-.chroma .gt { color: #aa0000 }
-.chroma .kc { color: #000000; font-weight: bold }
-.chroma .kd { color: #000000; font-weight: bold }
-.chroma .kn { color: #000000; font-weight: bold }
-.chroma .kp { color: #000000; font-weight: bold }
-.chroma .kr { color: #000000; font-weight: bold }
-.chroma .kt { color: #445588; font-weight: bold }
-.chroma .m { color: #009999 }
-.chroma .s { color: #d01040 }
-.chroma .na { color: #008080 }
-.chroma .nb { color: #0086B3 }
-.chroma .nc { color: #445588; font-weight: bold }
-.chroma .no { color: #008080 }
-.chroma .nd { color: #3c5d5d; font-weight: bold }
-.chroma .ni { color: #800080 }
-.chroma .ne { color: #990000; font-weight: bold }
-.chroma .nf { color: #990000; font-weight: bold }
-.chroma .nl { color: #990000; font-weight: bold }
-.chroma .nn { color: #555555 }
-.chroma .nt { color: #000080 }
-.chroma .nv { color: #008080 }
-.chroma .ow { color: #000000; font-weight: bold }
-.chroma .w { color: #bbbbbb }
-.chroma .mb { color: #009999 }
-.chroma .mf { color: #009999 }
-.chroma .mh { color: #009999 }
-.chroma .mi { color: #009999 }
-.chroma .mo { color: #009999 }
-.chroma .sa { color: #d01040 }
-.chroma .sb { color: #d01040 }
-.chroma .sc { color: #d01040 }
-.chroma .dl { color: #d01040 }
-.chroma .sd { color: #d01040 }
-.chroma .s2 { color: #d01040 }
-.chroma .se { color: #d01040 }
-.chroma .sh { color: #d01040 }
-.chroma .si { color: #d01040 }
-.chroma .sx { color: #d01040 }
-.chroma .sr { color: #009926 }
-.chroma .s1 { color: #d01040 }
-.chroma .ss { color: #990073 }
-.chroma .bp { color: #999999 }
-.chroma .fm { color: #990000; font-weight: bold }
-.chroma .vc { color: #008080 }
-.chroma .vg { color: #008080 }
-.chroma .vi { color: #008080 }
-.chroma .vm { color: #008080 }
-.chroma .il { color: #009999 }
-`
+// countWords counts the words in the text of a document
+func countWords(doc ast.Node, source []byte) int {
+	words := 0
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if t, ok := n.(*ast.Text); ok && entering {
+			words += len(strings.Fields(string(t.Segment.Value(source))))
+		}
+		return ast.WalkContinue, nil
+	})
+	return words
 }
@@ -0,0 +1,209 @@
+package converter
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// DefaultTOCDepth is the deepest heading level listed in the table of
+// contents of a document
+const DefaultTOCDepth = 3
+
+const (
+	// tocMarker is replaced by the table of contents
+	tocMarker = "<!-- TOC -->"
+
+	defaultTOCTitle = "Table of Contents"
+)
+
+// tocPageRegex matches the empty page number of a table of contents entry
+var tocPageRegex = regexp.MustCompile(`<a href="#([^"]*)">([^<]*)<span class="toc-page"></span>`)
+
+// kindTableOfContents is the node kind of tables of contents
+var kindTableOfContents = ast.NewNodeKind("TableOfContents")
+
+// tableOfContents lists the headings of a document
+type tableOfContents struct {
+	ast.BaseBlock
+	title    string
+	headings []heading
+	// pageNumbers leaves room for the page number of each entry
+	pageNumbers bool
+}
+
+// Kind implements ast.Node
+func (n *tableOfContents) Kind() ast.NodeKind {
+	return kindTableOfContents
+}
+
+// Dump implements ast.Node
+func (n *tableOfContents) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Title": n.title}, nil)
+}
+
+// tocExtension renders tables of contents
+type tocExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *tocExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(util.Prioritized(e, 500)),
+	)
+}
+
+// RegisterFuncs implements renderer.NodeRenderer
+func (e *tocExtension) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindTableOfContents, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			node := n.(*tableOfContents)
+			w.WriteString(tocHTML(node.title, node.headings, node.pageNumbers))
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// insertTOC replaces the <!-- TOC --> markers of a document with its table
+// of contents. Without a marker, the table of contents is added at the
+// start of the document when TOC is set.
+func (c *Converter) insertTOC(doc ast.Node, source []byte) {
+	var markers []ast.Node
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if block, ok := n.(*ast.HTMLBlock); ok && entering && strings.TrimSpace(codeBlockText(block, source)) == tocMarker {
+			markers = append(markers, n)
+		}
+		return ast.WalkContinue, nil
+	})
+	if len(markers) == 0 && !c.config.TOC {
+		return
+	}
+
+	depth := c.config.TOCDepth
+	if depth <= 0 {
+		depth = DefaultTOCDepth
+	}
+	var entries []heading
+	for _, h := range collectHeadings(doc, source) {
+		if h.Level <= depth {
+			entries = append(entries, h)
+		}
+	}
+
+	newTOC := func() ast.Node {
+		return &tableOfContents{title: c.tocTitle(), headings: entries, pageNumbers: c.config.TOCPageNumbers}
+	}
+	if len(markers) == 0 {
+		doc.InsertBefore(doc, doc.FirstChild(), newTOC())
+		return
+	}
+	for _, n := range markers {
+		n.Parent().ReplaceChild(n.Parent(), n, newTOC())
+	}
+}
+
+// bookTOC returns the table of contents of a book, which lists the top two
+// heading levels unless TOCDepth is set
+func (c *Converter) bookTOC(headings []heading) string {
+	if len(headings) == 0 {
+		return ""
+	}
+
+	depth := c.config.TOCDepth
+	if depth <= 0 {
+		top := headings[0].Level
+		for _, h := range headings {
+			top = min(top, h.Level)
+		}
+		depth = top + 1
+	}
+
+	var entries []heading
+	for _, h := range headings {
+		if h.Level <= depth {
+			entries = append(entries, h)
+		}
+	}
+
+	return tocHTML(c.tocTitle(), entries, c.config.TOCPageNumbers)
+}
+
+// tocTitle returns the title of the table of contents
+func (c *Converter) tocTitle() string {
+	if c.config.TOCTitle != "" {
+		return c.config.TOCTitle
+	}
+	return defaultTOCTitle
+}
+
+// tocHTML returns a table of contents listing the headings
+func tocHTML(title string, headings []heading, pageNumbers bool) string {
+	if len(headings) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("<div class=\"toc\">\n<h2>%s</h2>\n%s</div>\n", html.EscapeString(title), tocList(headings, pageNumbers))
+}
+
+// tocList returns nested lists linking to the headings, with an empty page
+// number for each when pageNumbers is set
+func tocList(headings []heading, pageNumbers bool) string {
+	var b strings.Builder
+
+	// Levels of the lists that are open
+	var levels []int
+	for _, h := range headings {
+		for len(levels) > 0 && levels[len(levels)-1] > h.Level {
+			b.WriteString("</li>\n</ul>\n")
+			levels = levels[:len(levels)-1]
+		}
+
+		if len(levels) == 0 || levels[len(levels)-1] < h.Level {
+			// A deeper list goes inside the open item
+			b.WriteString("<ul>\n")
+			levels = append(levels, h.Level)
+		} else {
+			b.WriteString("</li>\n")
+		}
+
+		fmt.Fprintf(&b, "<li><a href=\"#%s\">%s", html.EscapeString(h.ID), html.EscapeString(h.Title))
+		if pageNumbers {
+			b.WriteString("<span class=\"toc-page\"></span>")
+		}
+		b.WriteString("</a>")
+	}
+
+	for range levels {
+		b.WriteString("</li>\n</ul>\n")
+	}
+
+	return b.String()
+}
+
+// numberTOC fills in the page numbers of the table of contents, which are
+// looked up in the rendered PDF, and renders the HTML again
+func (c *Converter) numberTOC(styledHTML string, pdf []byte) ([]byte, error) {
+	if !tocPageRegex.MatchString(styledHTML) {
+		return pdf, nil
+	}
+
+	pages, err := destinationPages(pdf)
+	if err != nil {
+		return nil, err
+	}
+
+	numbered := tocPageRegex.ReplaceAllStringFunc(styledHTML, func(entry string) string {
+		m := tocPageRegex.FindStringSubmatch(entry)
+		page, ok := pages[html.UnescapeString(m[1])]
+		if !ok {
+			return entry
+		}
+		return strings.TrimSuffix(entry, "</span>") + strconv.Itoa(page) + "</span>"
+	})
+	return c.htmlToPDF(numbered)
+}
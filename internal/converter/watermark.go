@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultWatermarkOpacity  = 0.2
+	defaultWatermarkFontSize = 72
+
+	// draftWatermark is added to documents with "status: draft"
+	draftWatermark = "DRAFT"
+
+	// noWatermark removes a watermark set on the command line
+	noWatermark = "none"
+)
+
+// watermarkPositions maps position names to pdfcpu anchors
+var watermarkPositions = map[string]string{
+	"center":       "c",
+	"top":          "tc",
+	"bottom":       "bc",
+	"left":         "l",
+	"right":        "r",
+	"top-left":     "tl",
+	"top-right":    "tr",
+	"bottom-left":  "bl",
+	"bottom-right": "br",
+}
+
+// WatermarkOptions describes a watermark printed diagonally across every
+// page. In front matter it can be given as just the text:
+//
+//	watermark: CONFIDENTIAL
+//
+// or with options:
+//
+//	watermark:
+//	  text: CONFIDENTIAL
+//	  opacity: 0.3
+//	  position: bottom
+type WatermarkOptions struct {
+	// Text is the watermark text, unless Image is set
+	Text string `yaml:"text"`
+	// Image is the path of a PNG, JPEG or TIFF image to use instead of text
+	Image string `yaml:"image"`
+	// Opacity is between 0 and 1, 0.2 by default
+	Opacity float64 `yaml:"opacity"`
+	// Position is center (the default), top, bottom, left, right,
+	// top-left, top-right, bottom-left or bottom-right
+	Position string `yaml:"position"`
+	// FontSize is the text size in points, 72 by default
+	FontSize int `yaml:"font_size"`
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (w *WatermarkOptions) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return value.Decode(&w.Text)
+	}
+
+	// Decode into a type without this method to avoid recursion
+	type options WatermarkOptions
+	return value.Decode((*options)(w))
+}
+
+// WatermarkPositionNames returns the names accepted by
+// WatermarkOptions.Position
+func WatermarkPositionNames() []string {
+	names := make([]string, 0, len(watermarkPositions))
+	for name := range watermarkPositions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// merge overrides the options with the ones set in other
+func (w *WatermarkOptions) merge(other *WatermarkOptions) {
+	if other.Text != "" {
+		w.Text = other.Text
+		w.Image = ""
+	}
+	if other.Image != "" {
+		w.Image = other.Image
+		w.Text = ""
+	}
+	if other.Opacity != 0 {
+		w.Opacity = other.Opacity
+	}
+	if other.Position != "" {
+		w.Position = other.Position
+	}
+	if other.FontSize != 0 {
+		w.FontSize = other.FontSize
+	}
+}
+
+// enabled reports whether the options describe a watermark
+func (w *WatermarkOptions) enabled() bool {
+	return w != nil && (w.Image != "" || (w.Text != "" && w.Text != noWatermark))
+}
+
+// addWatermark stamps the configured watermark on every page
+func (c *Converter) addWatermark(ctx *model.Context) error {
+	opts := c.config.Watermark
+	if !opts.enabled() {
+		return nil
+	}
+
+	opacity := opts.Opacity
+	if opacity == 0 {
+		opacity = defaultWatermarkOpacity
+	}
+	if opacity < 0 || opacity > 1 {
+		return fmt.Errorf("watermark opacity must be between 0 and 1, got %g", opacity)
+	}
+
+	position := "center"
+	if opts.Position != "" {
+		position = strings.ToLower(opts.Position)
+	}
+	anchor, ok := watermarkPositions[position]
+	if !ok {
+		return fmt.Errorf("unknown watermark position %q (available: %s)", opts.Position, strings.Join(WatermarkPositionNames(), ", "))
+	}
+
+	desc := fmt.Sprintf("opacity:%g, position:%s", opacity, anchor)
+
+	// The watermark goes on top of the content, as Chrome paints an opaque
+	// page background that would hide it
+	var wm *model.Watermark
+	var err error
+	if opts.Image != "" {
+		wm, err = api.ImageWatermark(opts.Image, desc+", scalefactor:0.5 rel", true, false, types.POINTS)
+	} else {
+		fontSize := opts.FontSize
+		if fontSize == 0 {
+			fontSize = defaultWatermarkFontSize
+		}
+		desc += fmt.Sprintf(", points:%d, scalefactor:1 abs, fillcolor:#808080", fontSize)
+		wm, err = api.TextWatermark(opts.Text, desc, true, false, types.POINTS)
+	}
+	if err != nil {
+		return err
+	}
+
+	return api.WatermarkContext(ctx, nil, wm)
+}